@@ -0,0 +1,182 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/patch"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// Invocation is the request a client sends when it fires an action that
+// carries an ActionID.
+type Invocation struct {
+	ActionID string                 `json:"actionId"`
+	ScreenID string                 `json:"screenId,omitempty"`
+	Params   map[string]string      `json:"params,omitempty"`
+	Body     map[string]interface{} `json:"body,omitempty"`
+}
+
+// Result tells the client what to do with the response: bind Data, show a
+// new Screen, or apply Patch to the screen it is showing.
+type Result struct {
+	Type   string                 `json:"type"` // "data", "screen", "patch", "none"
+	Data   interface{}            `json:"data,omitempty"`
+	Screen *model.ComponentScreen `json:"screen,omitempty"`
+	Patch  patch.Patch            `json:"patch,omitempty"`
+}
+
+func DataResult(data interface{}) Result {
+	return Result{Type: "data", Data: data}
+}
+
+func ScreenResult(screen model.ComponentScreen) Result {
+	return Result{Type: "screen", Screen: &screen}
+}
+
+func PatchResult(p patch.Patch) Result {
+	return Result{Type: "patch", Patch: p}
+}
+
+func NoResult() Result {
+	return Result{Type: "none"}
+}
+
+type HandlerFunc func(ctx context.Context, invocation Invocation) (Result, error)
+
+// StatusError lets a handler choose the HTTP status of its failure, e.g.
+// 400 for missing params or 403 for an action the user may not run.
+type StatusError struct {
+	Status  int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return e.Message
+}
+
+func Errorf(status int, format string, args ...interface{}) error {
+	return &StatusError{Status: status, Message: fmt.Sprintf(format, args...)}
+}
+
+// MaxInvocationBytes bounds the size of an invocation request body.
+const MaxInvocationBytes = 1 << 20
+
+// Registry routes invocations to handlers by action ID. It is an
+// http.Handler and is safe for concurrent use.
+type Registry struct {
+	// ErrorLog receives handler errors that are not a StatusError, which
+	// clients only see as a generic message. The standard logger is used
+	// when it is nil.
+	ErrorLog *log.Logger
+
+	mu       sync.RWMutex
+	handlers map[string]HandlerFunc
+}
+
+func NewRegistry() *Registry {
+	return &Registry{handlers: make(map[string]HandlerFunc)}
+}
+
+// Register adds handler for actionID. Like http.Handle it panics on an empty
+// or already registered ID, since both are programming errors.
+func (r *Registry) Register(actionID string, handler HandlerFunc) {
+	if actionID == "" {
+		panic("action: empty action ID")
+	}
+	if handler == nil {
+		panic("action: nil handler for " + actionID)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.handlers[actionID]; exists {
+		panic("action: multiple registrations for " + actionID)
+	}
+	r.handlers[actionID] = handler
+}
+
+func (r *Registry) Lookup(actionID string) (HandlerFunc, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handler, ok := r.handlers[actionID]
+	return handler, ok
+}
+
+func (r *Registry) ActionIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.handlers))
+	for id := range r.handlers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Invoke runs the handler registered for invocation.ActionID.
+func (r *Registry) Invoke(ctx context.Context, invocation Invocation) (Result, error) {
+	handler, ok := r.Lookup(invocation.ActionID)
+	if !ok {
+		return Result{}, Errorf(http.StatusNotFound, "unknown action %q", invocation.ActionID)
+	}
+	return handler(ctx, invocation)
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "actions must be invoked with POST"})
+		return
+	}
+
+	var invocation Invocation
+	body := http.MaxBytesReader(w, req.Body, MaxInvocationBytes)
+	if err := json.NewDecoder(body).Decode(&invocation); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "invocation is too large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "reading invocation: " + err.Error()})
+		return
+	}
+	if invocation.ActionID == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invocation has no actionId"})
+		return
+	}
+
+	result, err := r.Invoke(req.Context(), invocation)
+	if err != nil {
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			writeJSON(w, statusErr.Status, map[string]string{"error": statusErr.Message})
+			return
+		}
+		r.logf("action %s: %v", invocation.ActionID, err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "internal error"})
+		return
+	}
+	if result.Type == "" {
+		result.Type = "none"
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (r *Registry) logf(format string, args ...interface{}) {
+	if r.ErrorLog != nil {
+		r.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,121 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/patch"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Register("like_post", func(ctx context.Context, inv Invocation) (Result, error) {
+		if inv.Params["postId"] == "" {
+			return Result{}, Errorf(http.StatusBadRequest, "postId is required")
+		}
+		return DataResult(map[string]interface{}{"postId": inv.Params["postId"], "liked": true}), nil
+	})
+	registry.Register("delete_post", func(ctx context.Context, inv Invocation) (Result, error) {
+		return Result{}, fmt.Errorf("query failed: dial tcp 10.0.0.5:5432: connection refused")
+	})
+	registry.Register("open_post", func(ctx context.Context, inv Invocation) (Result, error) {
+		screen := ui.NewScreen("post_"+inv.Params["postId"], "Post", "1.0").
+			WithLayout(ui.Text("Post " + inv.Params["postId"])).
+			Build()
+		return ScreenResult(screen), nil
+	})
+	registry.Register("increment", func(ctx context.Context, inv Invocation) (Result, error) {
+		return PatchResult(patch.Patch{
+			patch.UpdateProperties("count", map[string]interface{}{"text": "Count: 2"}),
+			patch.RemoveChild("todos", "todo_1"),
+		}), nil
+	})
+	return registry
+}
+
+func invoke(t *testing.T, handler http.Handler, method, body string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, "/actions", strings.NewReader(body)))
+	var response map[string]interface{}
+	if rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to decode response %q: %v", rec.Body, err)
+		}
+	}
+	return rec, response
+}
+
+func TestRegistryDispatch(t *testing.T) {
+	registry := testRegistry()
+	registry.ErrorLog = log.New(io.Discard, "", 0)
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		wantType   string
+	}{
+		{name: "data", method: http.MethodPost, body: `{"actionId":"like_post","params":{"postId":"7"}}`, wantStatus: http.StatusOK, wantType: "data"},
+		{name: "screen", method: http.MethodPost, body: `{"actionId":"open_post","params":{"postId":"7"}}`, wantStatus: http.StatusOK, wantType: "screen"},
+		{name: "patch", method: http.MethodPost, body: `{"actionId":"increment"}`, wantStatus: http.StatusOK, wantType: "patch"},
+		{name: "handler status error", method: http.MethodPost, body: `{"actionId":"like_post"}`, wantStatus: http.StatusBadRequest},
+		{name: "internal error", method: http.MethodPost, body: `{"actionId":"delete_post"}`, wantStatus: http.StatusInternalServerError},
+		{name: "oversized body", method: http.MethodPost, body: `{"actionId":"like_post","body":{"text":"` + strings.Repeat("a", MaxInvocationBytes) + `"}}`, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "unknown action", method: http.MethodPost, body: `{"actionId":"share_post"}`, wantStatus: http.StatusNotFound},
+		{name: "missing action ID", method: http.MethodPost, body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "wrong method", method: http.MethodGet, body: ``, wantStatus: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, response := invoke(t, registry, tt.method, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body)
+			}
+			if message, _ := response["error"].(string); strings.Contains(message, "10.0.0.5") {
+				t.Errorf("Internal error details leaked to the client: %q", message)
+			}
+			if tt.wantType != "" && response["type"] != tt.wantType {
+				t.Errorf("Expected result type %q, got %v", tt.wantType, response)
+			}
+		})
+	}
+}
+
+func TestRegistryPatchResult(t *testing.T) {
+	rec, _ := invoke(t, testRegistry(), http.MethodPost, `{"actionId":"increment"}`)
+	var response Result
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode patch result: %v", err)
+	}
+	if response.Type != "patch" || len(response.Patch) != 2 {
+		t.Fatalf("Expected a patch with 2 operations, got %s", rec.Body)
+	}
+	update, remove := response.Patch[0], response.Patch[1]
+	if update.Op != patch.OpUpdateProperties || update.Target != "count" || update.Properties["text"] != "Count: 2" {
+		t.Errorf("Unexpected update operation %+v", update)
+	}
+	if remove.Op != patch.OpRemoveChild || remove.Target != "todos" || remove.Child != "todo_1" {
+		t.Errorf("Unexpected remove operation %+v", remove)
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	registry := testRegistry()
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected duplicate registration to panic")
+		}
+	}()
+	registry.Register("like_post", func(ctx context.Context, inv Invocation) (Result, error) {
+		return NoResult(), nil
+	})
+}
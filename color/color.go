@@ -0,0 +1,238 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Color struct {
+	R uint8
+	G uint8
+	B uint8
+	A uint8
+}
+
+func RGB(r, g, b uint8) Color {
+	return Color{R: r, G: g, B: b, A: 255}
+}
+
+// Parse accepts #RGB, #RGBA, #RRGGBB, #RRGGBBAA, rgb(), rgba(), hsl() and
+// hsla(). Named colors are rejected on purpose: clients disagree on them.
+func Parse(s string) (Color, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case strings.HasPrefix(value, "#"):
+		return parseHex(value[1:], s)
+	case strings.HasPrefix(value, "rgb"):
+		return parseRGB(value, s)
+	case strings.HasPrefix(value, "hsl"):
+		return parseHSL(value, s)
+	default:
+		return Color{}, fmt.Errorf("unsupported color %q", s)
+	}
+}
+
+func MustParse(s string) Color {
+	c, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Normalize rewrites any supported color into the canonical #RRGGBB form,
+// or #RRGGBBAA when the color is not fully opaque.
+func Normalize(s string) (string, error) {
+	c, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return c.Hex(), nil
+}
+
+func (c Color) Hex() string {
+	if c.A == 255 {
+		return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02X%02X%02X%02X", c.R, c.G, c.B, c.A)
+}
+
+func (c Color) String() string {
+	return c.Hex()
+}
+
+func (c Color) Opaque() bool {
+	return c.A == 255
+}
+
+// Over composites c on top of background, which is treated as opaque.
+func (c Color) Over(background Color) Color {
+	alpha := float64(c.A) / 255
+	blend := func(fg, bg uint8) uint8 {
+		return uint8(math.Round(float64(fg)*alpha + float64(bg)*(1-alpha)))
+	}
+	return Color{
+		R: blend(c.R, background.R),
+		G: blend(c.G, background.G),
+		B: blend(c.B, background.B),
+		A: 255,
+	}
+}
+
+func parseHex(digits, original string) (Color, error) {
+	switch len(digits) {
+	case 3, 4:
+		expanded := make([]byte, 0, len(digits)*2)
+		for i := 0; i < len(digits); i++ {
+			expanded = append(expanded, digits[i], digits[i])
+		}
+		digits = string(expanded)
+	case 6, 8:
+	default:
+		return Color{}, fmt.Errorf("invalid hex color %q: expected 3, 4, 6 or 8 digits", original)
+	}
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hex color %q", original)
+	}
+	return Color{
+		R: uint8(value >> 24),
+		G: uint8(value >> 16),
+		B: uint8(value >> 8),
+		A: uint8(value),
+	}, nil
+}
+
+func functionArgs(value, original string) (string, []string, error) {
+	open := strings.Index(value, "(")
+	if open < 0 || !strings.HasSuffix(value, ")") {
+		return "", nil, fmt.Errorf("invalid color function %q", original)
+	}
+	name := strings.TrimSpace(value[:open])
+	body := value[open+1 : len(value)-1]
+
+	// Both the legacy "r, g, b, a" and the modern "r g b / a" syntax are allowed.
+	body = strings.NewReplacer(",", " ", "/", " ").Replace(body)
+	return name, strings.Fields(body), nil
+}
+
+func parseRGB(value, original string) (Color, error) {
+	name, args, err := functionArgs(value, original)
+	if err != nil {
+		return Color{}, err
+	}
+	if (name != "rgb" && name != "rgba") || (len(args) != 3 && len(args) != 4) {
+		return Color{}, fmt.Errorf("invalid rgb color %q", original)
+	}
+
+	var channels [3]uint8
+	for i := 0; i < 3; i++ {
+		v, err := parseComponent(args[i], 255)
+		if err != nil {
+			return Color{}, fmt.Errorf("invalid rgb color %q: %w", original, err)
+		}
+		channels[i] = uint8(math.Round(v))
+	}
+	alpha, err := parseAlpha(args, original)
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{R: channels[0], G: channels[1], B: channels[2], A: alpha}, nil
+}
+
+func parseHSL(value, original string) (Color, error) {
+	name, args, err := functionArgs(value, original)
+	if err != nil {
+		return Color{}, err
+	}
+	if (name != "hsl" && name != "hsla") || (len(args) != 3 && len(args) != 4) {
+		return Color{}, fmt.Errorf("invalid hsl color %q", original)
+	}
+
+	hue, err := strconv.ParseFloat(strings.TrimSuffix(args[0], "deg"), 64)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hsl color %q: bad hue", original)
+	}
+	saturation, err := parsePercent(args[1])
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hsl color %q: %w", original, err)
+	}
+	lightness, err := parsePercent(args[2])
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hsl color %q: %w", original, err)
+	}
+	alpha, err := parseAlpha(args, original)
+	if err != nil {
+		return Color{}, err
+	}
+
+	r, g, b := hslToRGB(math.Mod(math.Mod(hue, 360)+360, 360), saturation, lightness)
+	return Color{R: r, G: g, B: b, A: alpha}, nil
+}
+
+func parseAlpha(args []string, original string) (uint8, error) {
+	if len(args) < 4 {
+		return 255, nil
+	}
+	v, err := parseComponent(args[3], 1)
+	if err != nil {
+		return 0, fmt.Errorf("invalid alpha in %q: %w", original, err)
+	}
+	return uint8(math.Round(v * 255)), nil
+}
+
+// parseComponent reads a number in [0, max] or a percentage of max.
+func parseComponent(arg string, max float64) (float64, error) {
+	if strings.HasSuffix(arg, "%") {
+		p, err := parsePercent(arg)
+		return p * max, err
+	}
+	v, err := strconv.ParseFloat(arg, 64)
+	if err != nil || v < 0 || v > max {
+		return 0, fmt.Errorf("component %q out of range 0-%g", arg, max)
+	}
+	return v, nil
+}
+
+func parsePercent(arg string) (float64, error) {
+	if !strings.HasSuffix(arg, "%") {
+		return 0, fmt.Errorf("expected a percentage, got %q", arg)
+	}
+	v, err := strconv.ParseFloat(strings.TrimSuffix(arg, "%"), 64)
+	if err != nil || v < 0 || v > 100 {
+		return 0, fmt.Errorf("percentage %q out of range", arg)
+	}
+	return v / 100, nil
+}
+
+func hslToRGB(h, s, l float64) (uint8, uint8, uint8) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	toByte := func(v float64) uint8 {
+		return uint8(math.Round((v + m) * 255))
+	}
+	return toByte(r), toByte(g), toByte(b)
+}
@@ -0,0 +1,66 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"#fff", "#FFFFFF"},
+		{"#0f08", "#00FF0088"},
+		{"#FF9F43", "#FF9F43"},
+		{"#3b82f680", "#3B82F680"},
+		{"rgb(255, 159, 67)", "#FF9F43"},
+		{"rgba(0, 0, 0, 0.5)", "#00000080"},
+		{"rgb(100% 0% 0% / 50%)", "#FF000080"},
+		{"hsl(0, 100%, 50%)", "#FF0000"},
+		{"hsl(120deg 100% 25%)", "#008000"},
+		{"hsla(240, 100%, 50%, 1)", "#0000FF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Normalize(tt.input)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsInvalidColors(t *testing.T) {
+	for _, input := range []string{"#FF9F4", "orange", "#GGGGGG", "rgb(300, 0, 0)", "hsl(0, 100, 50)", ""} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Expected %q to be rejected", input)
+		}
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	black, white := RGB(0, 0, 0), RGB(255, 255, 255)
+	if ratio := ContrastRatio(black, white); math.Abs(ratio-21) > 0.01 {
+		t.Errorf("Expected black on white to be 21:1, got %.2f", ratio)
+	}
+	if ratio := ContrastRatio(white, white); ratio != 1 {
+		t.Errorf("Expected white on white to be 1:1, got %.2f", ratio)
+	}
+
+	gray := MustParse("#767676")
+	if !PassesAA(gray, white, false) {
+		t.Error("Expected #767676 on white to pass AA")
+	}
+	lightGray := MustParse("#888888")
+	if PassesAA(lightGray, white, false) {
+		t.Error("Expected #888888 on white to fail AA for normal text")
+	}
+	if !PassesAA(lightGray, white, true) {
+		t.Error("Expected #888888 on white to pass AA for large text")
+	}
+}
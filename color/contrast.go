@@ -0,0 +1,45 @@
+package color
+
+import "math"
+
+const (
+	// WCAG 2.1 AA minimum contrast ratios.
+	MinContrastNormalText = 4.5
+	MinContrastLargeText  = 3.0
+)
+
+func (c Color) RelativeLuminance() float64 {
+	linear := func(channel uint8) float64 {
+		v := float64(channel) / 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// ContrastRatio returns the WCAG contrast ratio between foreground and
+// background. A translucent foreground is composited over the background first.
+func ContrastRatio(foreground, background Color) float64 {
+	if !background.Opaque() {
+		background = background.Over(RGB(255, 255, 255))
+	}
+	if !foreground.Opaque() {
+		foreground = foreground.Over(background)
+	}
+	l1, l2 := foreground.RelativeLuminance(), background.RelativeLuminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// PassesAA reports whether the pair meets WCAG AA for normal or large text.
+func PassesAA(foreground, background Color, largeText bool) bool {
+	minimum := MinContrastNormalText
+	if largeText {
+		minimum = MinContrastLargeText
+	}
+	return ContrastRatio(foreground, background) >= minimum
+}
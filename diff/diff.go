@@ -0,0 +1,346 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"strconv"
+	"strings"
+)
+
+type Kind string
+
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Moved   Kind = "moved"
+	Changed Kind = "changed"
+)
+
+// Change is one difference between two screens. Field is the changed
+// attribute, e.g. "properties.text", "modifier.padding" or "actions.onClick",
+// and is empty for added, removed and moved nodes. Old and New hold JSON.
+type Change struct {
+	Kind    Kind   `json:"kind"`
+	Node    string `json:"node,omitempty"`
+	Path    string `json:"path"`
+	OldPath string `json:"oldPath,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Old     string `json:"old,omitempty"`
+	New     string `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s at %s", c.Node, c.Path)
+	case Removed:
+		return fmt.Sprintf("- %s at %s", c.Node, c.Path)
+	case Moved:
+		return fmt.Sprintf("> %s moved %s -> %s", c.Node, c.OldPath, c.Path)
+	}
+	if c.Node == "" {
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, orNone(c.Old), orNone(c.New))
+	}
+	return fmt.Sprintf("~ %s at %s: %s: %s -> %s", c.Node, c.Path, c.Field, orNone(c.Old), orNone(c.New))
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+func (r Report) Empty() bool {
+	return len(r.Changes) == 0
+}
+
+// String renders one change per line, suitable for review comments and
+// test failure messages.
+func (r Report) String() string {
+	if r.Empty() {
+		return "no changes"
+	}
+	lines := make([]string, len(r.Changes))
+	for i, change := range r.Changes {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Screens compares two screens structurally. Nodes are matched by ID and,
+// when they have none, by path. Screen-level changes come first, then removed
+// nodes in old order, then added, moved and changed nodes in new order.
+func Screens(old, new model.ComponentScreen) Report {
+	var report Report
+	report.Changes = append(report.Changes, valueChange("screen.version", old.Version, new.Version)...)
+	report.Changes = append(report.Changes, valueChange("screen.id", old.Screen.ID, new.Screen.ID)...)
+	report.Changes = append(report.Changes, valueChange("screen.title", old.Screen.Title, new.Screen.Title)...)
+	report.Changes = append(report.Changes, fieldChanges("", "theme", toFields(old.Theme), toFields(new.Theme), Change{})...)
+	report.Changes = append(report.Changes, fieldChanges("", "data", toFields(old.Data), toFields(new.Data), Change{})...)
+
+	oldEntries := flatten(old.Screen.Layout)
+	newEntries := flatten(new.Screen.Layout)
+	matches := match(oldEntries, newEntries)
+
+	matchedOld := make(map[int]bool)
+	for _, oldIndex := range matches {
+		matchedOld[oldIndex] = true
+	}
+	for i, entry := range oldEntries {
+		if !matchedOld[i] {
+			report.Changes = append(report.Changes, Change{Kind: Removed, Node: label(entry.node), Path: entry.path})
+		}
+	}
+
+	for i, entry := range newEntries {
+		oldIndex, ok := matches[i]
+		if !ok {
+			report.Changes = append(report.Changes, Change{Kind: Added, Node: label(entry.node), Path: entry.path})
+			continue
+		}
+		oldEntry := oldEntries[oldIndex]
+		if moved(oldEntries, newEntries, matches, oldIndex, i) {
+			report.Changes = append(report.Changes, Change{Kind: Moved, Node: label(entry.node), Path: entry.path, OldPath: oldEntry.path})
+		}
+		node := Change{Node: label(entry.node), Path: entry.path}
+		report.Changes = append(report.Changes, fieldChanges("", "", nodeFields(oldEntry.node), nodeFields(entry.node), node)...)
+	}
+	return report
+}
+
+// entry is a node in a flattened tree. parent is the index of the parent
+// entry, -1 for the root, and rank orders children of the same parent.
+type entry struct {
+	node   model.ComponentNode
+	path   string
+	parent int
+	slot   string
+	rank   int
+}
+
+func flatten(root model.ComponentNode) []entry {
+	var entries []entry
+	indexes := make(map[*model.ComponentNode]int)
+	tree.Walk(&root, "screen.layout", func(node, parent *model.ComponentNode, path string) error {
+		e := entry{node: *node, path: path, parent: -1}
+		if parent != nil {
+			e.parent = indexes[parent]
+			e.slot = strings.TrimPrefix(path, entries[e.parent].path+".")
+			if index, ok := strings.CutPrefix(e.slot, "children["); ok {
+				e.slot = "children"
+				e.rank, _ = strconv.Atoi(strings.TrimSuffix(index, "]"))
+			}
+		}
+		indexes[node] = len(entries)
+		entries = append(entries, e)
+		return nil
+	})
+	return entries
+}
+
+// match maps new entry indexes to old ones, by ID first and then by path
+// for nodes without an ID.
+func match(oldEntries, newEntries []entry) map[int]int {
+	oldByID := make(map[string]int)
+	oldByPath := make(map[string]int)
+	for i, entry := range oldEntries {
+		if entry.node.ID != nil {
+			if _, dup := oldByID[*entry.node.ID]; !dup {
+				oldByID[*entry.node.ID] = i
+			}
+		} else {
+			oldByPath[entry.path] = i
+		}
+	}
+
+	matches := make(map[int]int)
+	used := make(map[int]bool)
+	for i, entry := range newEntries {
+		var oldIndex int
+		var ok bool
+		if entry.node.ID != nil {
+			oldIndex, ok = oldByID[*entry.node.ID]
+		} else {
+			oldIndex, ok = oldByPath[entry.path]
+		}
+		if ok && !used[oldIndex] {
+			matches[i] = oldIndex
+			used[oldIndex] = true
+		}
+	}
+	return matches
+}
+
+// moved reports a node whose parent or slot changed, or whose position among
+// siblings present in both screens changed. Shifts caused only by siblings
+// being added or removed are not moves.
+func moved(oldEntries, newEntries []entry, matches map[int]int, oldIndex, newIndex int) bool {
+	oldEntry, newEntry := oldEntries[oldIndex], newEntries[newIndex]
+	if oldEntry.slot != newEntry.slot {
+		return true
+	}
+	if newEntry.parent < 0 || oldEntry.parent < 0 {
+		return newEntry.parent != oldEntry.parent
+	}
+	if matchedParent, ok := matches[newEntry.parent]; !ok || matchedParent != oldEntry.parent {
+		return true
+	}
+	if newEntry.slot != "children" {
+		return false
+	}
+
+	oldRank, newRank := 0, 0
+	for i, sibling := range newEntries {
+		siblingOld, ok := matches[i]
+		if !ok || sibling.parent != newEntry.parent || sibling.slot != "children" || oldEntries[siblingOld].parent != oldEntry.parent {
+			continue
+		}
+		if sibling.rank < newEntry.rank {
+			newRank++
+		}
+		if oldEntries[siblingOld].rank < oldEntry.rank {
+			oldRank++
+		}
+	}
+	return oldRank != newRank
+}
+
+// nestedFields are compared key by key rather than as a whole.
+var nestedFields = map[string]bool{
+	"properties": true,
+	"modifier":   true,
+	"actions":    true,
+}
+
+// nodeFields is the node as JSON fields, without the child nodes that are
+// compared as entries of their own. Data source items are fields of their own
+// so a change is reported per item.
+func nodeFields(node model.ComponentNode) map[string]json.RawMessage {
+	node.Children = nil
+	node.EmptyTemplate = nil
+	node.ErrorTemplate = nil
+	if node.ItemTemplate != nil {
+		itemTemplate := *node.ItemTemplate
+		itemTemplate.Layout = model.ComponentNode{}
+		itemTemplate.Templates = nil
+		node.ItemTemplate = &itemTemplate
+	}
+	if node.LoadingTemplate != nil {
+		loading := *node.LoadingTemplate
+		loading.Layout = nil
+		node.LoadingTemplate = &loading
+	}
+	if node.Sections != nil {
+		sections := *node.Sections
+		sections.HeaderTemplate = model.ComponentNode{}
+		node.Sections = &sections
+	}
+	fields := toFields(node)
+	if node.DataSource != nil {
+		dataSource := toFields(node.DataSource)
+		delete(dataSource, "items")
+		if encoded, err := json.Marshal(dataSource); err == nil {
+			fields["dataSource"] = encoded
+		}
+		for i, item := range node.DataSource.Items {
+			if encoded, err := json.Marshal(withoutTemplate(item)); err == nil {
+				fields[fmt.Sprintf("dataSource.items[%d]", i)] = encoded
+			}
+		}
+	}
+	return fields
+}
+
+// withoutTemplate drops the template embedded in a data source item, whether
+// it is held as a node or, in a screen decoded from JSON, as a map.
+func withoutTemplate(item map[string]interface{}) map[string]interface{} {
+	switch item["template"].(type) {
+	case model.ComponentNode, map[string]interface{}:
+	default:
+		return item
+	}
+	stripped := make(map[string]interface{}, len(item)-1)
+	for key, value := range item {
+		if key != "template" {
+			stripped[key] = value
+		}
+	}
+	return stripped
+}
+
+func toFields(value interface{}) map[string]json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil {
+		return nil
+	}
+	return fields
+}
+
+func fieldChanges(prefix, name string, old, new map[string]json.RawMessage, base Change) []Change {
+	var changes []Change
+	keys := make(map[string]bool)
+	for key := range old {
+		keys[key] = true
+	}
+	for key := range new {
+		keys[key] = true
+	}
+	for _, key := range utils.SortedKeys(keys) {
+		oldValue, newValue := normalize(old[key]), normalize(new[key])
+		if oldValue == newValue {
+			continue
+		}
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+		if prefix == "" && nestedFields[key] {
+			changes = append(changes, fieldChanges(key, "", toFields(old[key]), toFields(new[key]), base)...)
+			continue
+		}
+		change := base
+		change.Kind = Changed
+		change.Old, change.New = oldValue, newValue
+		if base.Node == "" {
+			change.Path = "screen." + name + "." + field
+		} else {
+			change.Field = field
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func valueChange(path, old, new string) []Change {
+	if old == new {
+		return nil
+	}
+	return []Change{{Kind: Changed, Path: path, Old: fmt.Sprintf("%q", old), New: fmt.Sprintf("%q", new)}}
+}
+
+// normalize treats absent and null values alike.
+func normalize(value json.RawMessage) string {
+	if value == nil || string(value) == "null" {
+		return ""
+	}
+	return string(value)
+}
+
+func label(node model.ComponentNode) string {
+	if node.ID != nil {
+		return node.Type + "#" + *node.ID
+	}
+	return node.Type
+}
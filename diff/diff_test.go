@@ -0,0 +1,138 @@
+package diff
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"strings"
+	"testing"
+)
+
+func productScreen(title string, children ...model.ComponentNode) model.ComponentScreen {
+	return ui.NewScreen("product", title, "1.0").
+		WithLayout(ui.NewComponent("column").WithID("root").WithChildren(children...).Build()).
+		Build()
+}
+
+func TestScreensReport(t *testing.T) {
+	price := func(text string) model.ComponentNode {
+		return ui.NewComponent("text").WithID("price").WithProperty("text", text).Build()
+	}
+	buy := ui.NewComponent("button").WithID("buy").
+		WithProperty("text", "Buy").
+		WithAction("onClick", ui.NavigationAction("cart")).
+		Build()
+	movedBuy := ui.NewComponent("button").WithID("buy").
+		WithProperty("text", "Buy").
+		WithAction("onClick", ui.NavigationAction("checkout")).
+		WithModifier(ui.PaddingModifier(8)).
+		Build()
+	share := ui.NewComponent("button").WithID("share").WithProperty("text", "Share").Build()
+	gallery := ui.NewComponent("row").WithID("gallery").Build()
+
+	old := productScreen("Product", ui.Text("Headline"), price("$10"), buy, share)
+	new := productScreen("Product details", ui.Text("Headline"), movedBuy, price("$12"), gallery)
+
+	report := Screens(old, new)
+	got := report.String()
+	want := []string{
+		`~ screen.title: "Product" -> "Product details"`,
+		`- button#share at screen.layout.children[3]`,
+		`> button#buy moved screen.layout.children[2] -> screen.layout.children[1]`,
+		`~ button#buy at screen.layout.children[1]: actions.onClick: {"type":"navigation","destination":"cart","params":null} -> {"type":"navigation","destination":"checkout","params":null}`,
+		`~ button#buy at screen.layout.children[1]: modifier.padding: (none) -> "8dp"`,
+		`> text#price moved screen.layout.children[1] -> screen.layout.children[2]`,
+		`~ text#price at screen.layout.children[2]: properties.text: "$10" -> "$12"`,
+		`+ row#gallery at screen.layout.children[3]`,
+	}
+	if got != strings.Join(want, "\n") {
+		t.Errorf("Unexpected report:\n%s\n\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestScreensUnchanged(t *testing.T) {
+	screen := productScreen("Product", ui.Text("Headline"))
+	if report := Screens(screen, screen); !report.Empty() || report.String() != "no changes" {
+		t.Errorf("Expected no changes, got:\n%s", report)
+	}
+}
+
+func TestInsertionIsNotAMove(t *testing.T) {
+	a := ui.NewComponent("text").WithID("a").Build()
+	b := ui.NewComponent("text").WithID("b").Build()
+	inserted := ui.NewComponent("text").WithID("new").Build()
+
+	report := Screens(productScreen("P", a, b), productScreen("P", inserted, a, b))
+	if len(report.Changes) != 1 || report.Changes[0].Kind != Added {
+		t.Errorf("Expected a single addition, got:\n%s", report)
+	}
+}
+
+func TestEmbeddedItemTemplateChanges(t *testing.T) {
+	feed := func(title string) model.ComponentScreen {
+		list := ui.NewComponent("lazy_column").WithID("feed").
+			WithDataSource(ui.EnhancedStaticDataSource([]map[string]interface{}{
+				ui.ItemWithTemplate(map[string]interface{}{"title": "Sale"}, ui.StyledText(title, model.Headline6)),
+			})).
+			Build()
+		return productScreen("Feed", list)
+	}
+
+	report := Screens(feed("{{title}}"), feed("{{title}}!"))
+	want := `~ text at screen.layout.children[0].dataSource.items[0].template: properties.text: "{{title}}" -> "{{title}}!"`
+	if report.String() != want {
+		t.Errorf("Expected the embedded template change at its own path, got:\n%s", report)
+	}
+}
+
+func TestDecodedScreenItemChanges(t *testing.T) {
+	decode := func(screen model.ComponentScreen) model.ComponentScreen {
+		data, err := json.Marshal(screen)
+		if err != nil {
+			t.Fatalf("Failed to marshal screen: %v", err)
+		}
+		var decoded model.ComponentScreen
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Failed to unmarshal screen: %v", err)
+		}
+		return decoded
+	}
+	feed := func(title, price string) model.ComponentScreen {
+		list := ui.NewComponent("lazy_column").WithID("feed").
+			WithDataSource(ui.EnhancedStaticDataSource([]map[string]interface{}{
+				ui.ItemWithTemplate(map[string]interface{}{"title": "Sale"}, ui.Text(title)),
+				{"title": "Shoes", "price": price},
+			})).
+			Build()
+		return decode(productScreen("Feed", list))
+	}
+
+	report := Screens(feed("{{title}}", "10"), feed("{{title}}!", "12")).String()
+	want := strings.Join([]string{
+		`~ lazy_column#feed at screen.layout.children[0]: dataSource.items[1]: {"price":"10","title":"Shoes"} -> {"price":"12","title":"Shoes"}`,
+		`~ text at screen.layout.children[0].dataSource.items[0].template: properties.text: "{{title}}" -> "{{title}}!"`,
+	}, "\n")
+	if report != want {
+		t.Errorf("Expected per-item changes, got:\n%s\nwant:\n%s", report, want)
+	}
+}
+
+func TestListTemplateChanges(t *testing.T) {
+	events := func(header, skeleton string) model.ComponentScreen {
+		list := ui.NewComponent("lazy_column").WithID("events").
+			WithSections(ui.Sections("day", ui.Text(header))).
+			WithLoadingTemplate(model.LoadingState{Type: "skeleton", Layout: ui.NodePtr(ui.Text(skeleton))}).
+			Build()
+		return productScreen("Events", list)
+	}
+
+	report := Screens(events("{{section}}", "Loading"), events("{{section}}:", "Loading…")).String()
+	for _, want := range []string{
+		`~ text at screen.layout.children[0].loadingTemplate.layout: properties.text: "Loading" -> "Loading…"`,
+		`~ text at screen.layout.children[0].sections.headerTemplate: properties.text: "{{section}}" -> "{{section}}:"`,
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected %q in report, got:\n%s", want, report)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package form
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"log"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// MaxBodyBytes bounds the size of a submitted form body.
+const MaxBodyBytes = 10 << 20
+
+// ErrMisconfigured is returned by Decode when the submit action does not
+// match the fields under root. It is a server-side mistake, not a bad request.
+var ErrMisconfigured = errors.New("submit action does not match the screen")
+
+// SubmittedFields returns the fields under root that submit collects, in
+// the order the action names them. Each collected name must belong to exactly
+// one field under root.
+func SubmittedFields(root model.ComponentNode, submit model.ActionConfig) ([]Field, error) {
+	if submit.Type != "submit_form" {
+		return nil, fmt.Errorf("expected a submit_form action, got %q", submit.Type)
+	}
+	fields, err := Fields(root)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string][]Field, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = append(byName[field.Name], field)
+	}
+	submitted := make([]Field, 0, len(submit.Fields))
+	for _, name := range submit.Fields {
+		switch matches := byName[name]; len(matches) {
+		case 0:
+			return nil, fmt.Errorf("submit_form collects %q but the screen has no field with that name", name)
+		case 1:
+			submitted = append(submitted, matches[0])
+		default:
+			return nil, fmt.Errorf("submit_form collects %q but %d fields on the screen have that name", name, len(matches))
+		}
+	}
+	return submitted, nil
+}
+
+// Decode reads a form submitted by the submit action from r, validates it
+// against the fields the action collects and decodes their values into v
+// using its json tags. Fields the action does not collect, such as those of a
+// second form on the same screen, are ignored; names are matched screen-wide,
+// so the two forms must not share the names they collect. JSON, form-encoded and multipart
+// bodies are accepted. Validation failures are returned as Errors so
+// callers can report them per field.
+func Decode(r *http.Request, root model.ComponentNode, submit model.ActionConfig, v interface{}) error {
+	fields, err := SubmittedFields(root, submit)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMisconfigured, err)
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, MaxBodyBytes)
+	payload, err := readPayload(r)
+	if err != nil {
+		return err
+	}
+	if errs := ValidateFields(fields, payload); len(errs) > 0 {
+		return errs
+	}
+
+	data, err := json.Marshal(normalize(fields, payload))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decoding form into %T: %w", v, err)
+	}
+	return nil
+}
+
+func readPayload(r *http.Request) (map[string]interface{}, error) {
+	payload := make(map[string]interface{})
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("reading form body: %w", err)
+		}
+		for key, values := range r.PostForm {
+			if len(values) > 0 {
+				payload[key] = values[0]
+			}
+		}
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(MaxBodyBytes); err != nil {
+			return nil, fmt.Errorf("reading multipart body: %w", err)
+		}
+		for key, values := range r.MultipartForm.Value {
+			if len(values) > 0 {
+				payload[key] = values[0]
+			}
+		}
+	default:
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			return nil, fmt.Errorf("reading JSON body: %w", err)
+		}
+	}
+	return payload, nil
+}
+
+// normalize keeps only the submitted fields and replaces each value with its
+// typed form so that "42" from a form-encoded body decodes into an int field.
+// Dates stay ISO strings and empty values are dropped so they decode as zero
+// values.
+func normalize(fields []Field, payload map[string]interface{}) map[string]interface{} {
+	normalized := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, ok := payload[field.Name]
+		if !ok || value == nil || value == "" {
+			continue
+		}
+		normalized[field.Name] = value
+		typed, err := field.coerce(value)
+		if err != nil {
+			continue
+		}
+		if _, isDate := typed.(time.Time); !isDate {
+			normalized[field.Name] = typed
+		}
+	}
+	return normalized
+}
+
+// SubmitHandler decodes and validates submissions made by Submit on the
+// screen under Root before calling Handle. Invalid submissions get a 422
+// response listing the field errors. A non-nil result from Handle is written
+// as JSON, otherwise the response is 204 No Content.
+type SubmitHandler[T any] struct {
+	Root   model.ComponentNode
+	Submit model.ActionConfig
+	Handle func(r *http.Request, value T) (interface{}, error)
+
+	// ErrorLog receives errors from Handle and submit actions that do not
+	// match Root, which clients only see as a generic message. The standard
+	// logger is used when it is nil.
+	ErrorLog *log.Logger
+}
+
+// Handler returns a SubmitHandler for submit on the screen under root.
+func Handler[T any](root model.ComponentNode, submit model.ActionConfig, handle func(r *http.Request, value T) (interface{}, error)) *SubmitHandler[T] {
+	return &SubmitHandler[T]{Root: root, Submit: submit, Handle: handle}
+}
+
+func (h *SubmitHandler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var value T
+	if err := Decode(r, h.Root, h.Submit, &value); err != nil {
+		var fieldErrs Errors
+		if errors.As(err, &fieldErrs) {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": fieldErrs})
+			return
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "form body is too large"})
+			return
+		}
+		if errors.Is(err, ErrMisconfigured) {
+			h.logf("form submission to %s: %v", r.URL.Path, err)
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "internal error"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	result, err := h.Handle(r, value)
+	if err != nil {
+		// The cause may reveal internals, so clients only get a generic message.
+		h.logf("form submission to %s: %v", r.URL.Path, err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "internal error"})
+		return
+	}
+	if result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *SubmitHandler[T]) logf(format string, args ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,181 @@
+package form
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type signup struct {
+	Username string  `json:"username"`
+	Age      int     `json:"age"`
+	Plan     string  `json:"plan"`
+	Start    string  `json:"start"`
+	Terms    bool    `json:"terms"`
+	Volume   float64 `json:"volume"`
+}
+
+var signupSubmit = ui.SubmitForm("/signup", "username", "age", "plan", "start", "terms", "volume").Build()
+
+func TestDecodeFormEncoded(t *testing.T) {
+	body := url.Values{
+		"username": {"ada_l"},
+		"age":      {"36"},
+		"plan":     {"pro"},
+		"start":    {"2024-06-01"},
+		"terms":    {"on"},
+		"volume":   {""},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var got signup
+	if err := Decode(req, signupForm(), signupSubmit, &got); err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	want := signup{Username: "ada_l", Age: 36, Plan: "pro", Start: "2024-06-01", Terms: true}
+	if got != want {
+		t.Errorf("Decode = %+v, want %+v", got, want)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var received signup
+	handler := Handler(signupForm(), signupSubmit, func(r *http.Request, value signup) (interface{}, error) {
+		received = value
+		return map[string]string{"status": "created"}, nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup",
+		strings.NewReader(`{"username":"ada_l","age":36,"terms":true}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if received.Username != "ada_l" || received.Age != 36 {
+		t.Errorf("Handler received %+v", received)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup",
+		strings.NewReader(`{"username":"ada_l","age":5}`)))
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422, got %d: %s", rec.Code, rec.Body)
+	}
+	var response struct {
+		Errors []FieldError `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+	if len(response.Errors) != 2 {
+		t.Errorf("Expected age and terms errors, got %v", response.Errors)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`not json`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a malformed body, got %d", rec.Code)
+	}
+}
+
+func TestDecodeMultipart(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for key, value := range map[string]string{"username": "ada_l", "age": "36", "terms": "true"} {
+		writer.WriteField(key, value)
+	}
+	writer.Close()
+	req := httptest.NewRequest(http.MethodPost, "/signup", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var got signup
+	if err := Decode(req, signupForm(), signupSubmit, &got); err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if got.Username != "ada_l" || got.Age != 36 || !got.Terms {
+		t.Errorf("Multipart values were not decoded: %+v", got)
+	}
+}
+
+func TestDecodeOnlySubmittedFields(t *testing.T) {
+	screen := ui.Column(
+		signupForm(),
+		ui.TextField("email", "Newsletter email").WithRules(ui.Required("Enter your email")).Build(),
+	)
+	newsletter := ui.SubmitForm("/newsletter", "email").Build()
+
+	var got struct {
+		Email    string `json:"email"`
+		Username string `json:"username"`
+	}
+	req := httptest.NewRequest(http.MethodPost, "/newsletter", strings.NewReader(`{"email":"ada@example.com","username":"x"}`))
+	if err := Decode(req, screen, newsletter, &got); err != nil {
+		t.Fatalf("Other forms' required fields should not be validated: %v", err)
+	}
+	if got.Email != "ada@example.com" || got.Username != "" {
+		t.Errorf("Expected only the submitted field to be decoded, got %+v", got)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/newsletter", strings.NewReader(`{}`))
+	if err := Decode(req, screen, ui.SubmitForm("/newsletter", "phone").Build(), &got); err == nil {
+		t.Error("Expected an error for a submit action naming an unknown field")
+	}
+	twice := ui.Column(screen, ui.TextField("email", "Work email").Build())
+	req = httptest.NewRequest(http.MethodPost, "/newsletter", strings.NewReader(`{"email":"ada@example.com"}`))
+	if err := Decode(req, twice, newsletter, &got); err == nil {
+		t.Error("Expected an error for a collected name shared by two fields")
+	}
+	req = httptest.NewRequest(http.MethodPost, "/newsletter", strings.NewReader(`{}`))
+	if err := Decode(req, screen, model.ActionConfig{Type: "api_call"}, &got); err == nil {
+		t.Error("Expected an error for a non submit_form action")
+	}
+}
+
+func TestHandlerInternalErrors(t *testing.T) {
+	var logged bytes.Buffer
+	misconfigured := Handler(signupForm(), ui.SubmitForm("/signup", "phone").Build(), func(r *http.Request, value signup) (interface{}, error) {
+		return nil, nil
+	})
+	misconfigured.ErrorLog = log.New(&logged, "", 0)
+	failing := Handler(signupForm(), signupSubmit, func(r *http.Request, value signup) (interface{}, error) {
+		return nil, errors.New("database password rejected")
+	})
+	failing.ErrorLog = log.New(&logged, "", 0)
+
+	for name, handler := range map[string]http.Handler{"misconfigured": misconfigured, "failing": failing} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup",
+			strings.NewReader(`{"username":"ada_l","age":36,"terms":true}`)))
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("%s: expected 500, got %d", name, rec.Code)
+		}
+		if strings.Contains(rec.Body.String(), "phone") || strings.Contains(rec.Body.String(), "password") {
+			t.Errorf("%s: internal details leaked to the client: %s", name, rec.Body)
+		}
+	}
+	if !strings.Contains(logged.String(), `"phone"`) || !strings.Contains(logged.String(), "database password rejected") {
+		t.Errorf("Expected both causes in the error log, got %q", logged.String())
+	}
+}
+
+func TestDecodeBodyLimit(t *testing.T) {
+	handler := Handler(signupForm(), signupSubmit, func(r *http.Request, value signup) (interface{}, error) {
+		return nil, nil
+	})
+	huge := `{"username":"` + strings.Repeat("a", MaxBodyBytes) + `"}`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(huge)))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413 for an oversized body, got %d", rec.Code)
+	}
+}
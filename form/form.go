@@ -0,0 +1,132 @@
+package form
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"strings"
+)
+
+var fieldTypes = map[string]bool{
+	"text_field":     true,
+	"password_field": true,
+	"number_field":   true,
+	"checkbox":       true,
+	"switch":         true,
+	"radio_group":    true,
+	"dropdown":       true,
+	"slider":         true,
+	"date_picker":    true,
+}
+
+func IsField(node model.ComponentNode) bool {
+	return fieldTypes[node.Type]
+}
+
+type Field struct {
+	Name    string
+	Type    string
+	Rules   []model.ValidationRule
+	Options []model.FieldOption
+	Min     *float64
+	Max     *float64
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Fields collects the input fields under root in document order. Fields
+// inside list item templates are per-item and not part of a form payload,
+// so only Children are searched.
+func Fields(root model.ComponentNode) ([]Field, error) {
+	var fields []Field
+	var collect func(node model.ComponentNode) error
+	collect = func(node model.ComponentNode) error {
+		if IsField(node) {
+			field, err := ParseField(node)
+			if err != nil {
+				return err
+			}
+			fields = append(fields, field)
+		}
+		for _, child := range node.Children {
+			if err := collect(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := collect(root); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ParseField reads field properties whether they were set by the ui
+// builders or decoded from JSON.
+func ParseField(node model.ComponentNode) (Field, error) {
+	name, ok := node.Properties["name"].(string)
+	if !ok || name == "" {
+		return Field{}, fmt.Errorf("%s field has no name", node.Type)
+	}
+	field := Field{Name: name, Type: node.Type}
+	if err := convertProperty(node.Properties["rules"], &field.Rules); err != nil {
+		return Field{}, fmt.Errorf("field %s: invalid rules: %w", name, err)
+	}
+	if err := convertProperty(node.Properties["options"], &field.Options); err != nil {
+		return Field{}, fmt.Errorf("field %s: invalid options: %w", name, err)
+	}
+	if err := convertProperty(node.Properties["min"], &field.Min); err != nil {
+		return Field{}, fmt.Errorf("field %s: invalid min: %w", name, err)
+	}
+	if err := convertProperty(node.Properties["max"], &field.Max); err != nil {
+		return Field{}, fmt.Errorf("field %s: invalid max: %w", name, err)
+	}
+	return field, nil
+}
+
+func convertProperty(value interface{}, target interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, target)
+}
+
+// Validate checks a submitted payload against the rules of every field
+// under root and returns one error per failed rule.
+func Validate(root model.ComponentNode, payload map[string]interface{}) (Errors, error) {
+	fields, err := Fields(root)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateFields(fields, payload), nil
+}
+
+func ValidateFields(fields []Field, payload map[string]interface{}) Errors {
+	var errs Errors
+	for _, field := range fields {
+		errs = append(errs, field.Validate(payload[field.Name])...)
+	}
+	return errs
+}
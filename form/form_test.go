@@ -0,0 +1,101 @@
+package form
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"testing"
+)
+
+func signupForm() model.ComponentNode {
+	return ui.Column(
+		ui.TextField("username", "Username").
+			WithRules(ui.Required("Pick a username"), ui.MinLength(3, "Too short"), ui.Pattern(`[a-z0-9_]+`, "Lowercase letters, digits and _ only")).
+			Build(),
+		ui.NumberField("age", "Age").
+			WithRules(ui.Min(13, "You must be 13 or older"), ui.Max(120, "Check your age")).
+			Build(),
+		ui.Dropdown("plan", "Plan", ui.Option("Free", "free"), ui.Option("Pro", "pro")).Build(),
+		ui.DatePicker("start", "Start date").WithRules(ui.MinDate("2024-01-01", "Too early")).Build(),
+		ui.Checkbox("terms", "I accept the terms").WithRules(ui.Required("You must accept the terms")).Build(),
+		ui.Slider("volume", "Volume", 0, 10, 1).Build(),
+	)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload map[string]interface{}
+		want    map[string]string
+	}{
+		{
+			name: "valid",
+			payload: map[string]interface{}{
+				"username": "ada_l", "age": 36.0, "plan": "pro", "start": "2024-06-01", "terms": true, "volume": 4.0,
+			},
+			want: map[string]string{},
+		},
+		{
+			name:    "missing required",
+			payload: map[string]interface{}{"terms": false},
+			want:    map[string]string{"username": "required", "terms": "required"},
+		},
+		{
+			name: "rule failures",
+			payload: map[string]interface{}{
+				"username": "Ad", "age": "9", "plan": "enterprise", "start": "2023-12-31", "terms": "on", "volume": 11.0,
+			},
+			want: map[string]string{"username": "minLength,pattern", "age": "min", "plan": "type", "start": "min", "volume": "range"},
+		},
+		{
+			name:    "wrong types",
+			payload: map[string]interface{}{"username": 42.0, "age": "old", "start": "01/02/2024", "terms": true},
+			want:    map[string]string{"username": "type", "age": "type", "start": "type"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := Validate(signupForm(), tt.payload)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			got := map[string]string{}
+			for _, e := range errs {
+				if got[e.Field] != "" {
+					got[e.Field] += ","
+				}
+				got[e.Field] += e.Rule
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected errors %v, got %v", tt.want, errs)
+			}
+			for field, rules := range tt.want {
+				if got[field] != rules {
+					t.Errorf("Field %s: expected rules %s, got %s", field, rules, got[field])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDecodedScreen(t *testing.T) {
+	// Rules must survive a JSON round trip so a server can validate against
+	// the same screen definition it sent to the client.
+	data, err := json.Marshal(signupForm())
+	if err != nil {
+		t.Fatalf("Failed to marshal form: %v", err)
+	}
+	var decoded model.ComponentNode
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal form: %v", err)
+	}
+
+	errs, err := Validate(decoded, map[string]interface{}{"username": "ada", "age": 200.0, "terms": true})
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if len(errs) != 1 || errs[0].Field != "age" || errs[0].Message != "Check your age" {
+		t.Errorf("Expected a single max error for age, got %v", errs)
+	}
+}
@@ -0,0 +1,217 @@
+package form
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const DateLayout = "2006-01-02"
+
+// Validate applies the field's type and rules to a single submitted value.
+// Like HTML forms, rules other than required are skipped for empty values.
+func (f Field) Validate(value interface{}) Errors {
+	var errs Errors
+	fail := func(rule, message string) {
+		errs = append(errs, FieldError{Field: f.Name, Rule: rule, Message: message})
+	}
+
+	if isEmpty(f.Type, value) {
+		for _, rule := range f.Rules {
+			if rule.Type == "required" {
+				fail(rule.Type, rule.Message)
+			}
+		}
+		return errs
+	}
+
+	typed, err := f.coerce(value)
+	if err != nil {
+		fail("type", err.Error())
+		return errs
+	}
+
+	for _, rule := range f.Rules {
+		ok, err := f.check(rule, typed)
+		if err != nil {
+			fail(rule.Type, err.Error())
+		} else if !ok {
+			fail(rule.Type, rule.Message)
+		}
+	}
+	if f.Min != nil && f.Max != nil {
+		if number, ok := typed.(float64); ok && (number < *f.Min || number > *f.Max) {
+			fail("range", fmt.Sprintf("must be between %g and %g", *f.Min, *f.Max))
+		}
+	}
+	return errs
+}
+
+func isEmpty(fieldType string, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		// A required checkbox or switch must be turned on, e.g. accepting terms.
+		return !v && (fieldType == "checkbox" || fieldType == "switch")
+	default:
+		return false
+	}
+}
+
+// coerce converts a value to float64, bool, time.Time or string according to
+// the field type. Strings are accepted for every type so that form-encoded
+// submissions validate the same way as JSON ones.
+func (f Field) coerce(value interface{}) (interface{}, error) {
+	switch f.Type {
+	case "number_field", "slider":
+		number, err := toFloat(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return number, nil
+	case "checkbox", "switch":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if v == "on" {
+				return true, nil
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("must be true or false")
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("must be true or false")
+	case "date_picker":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a date")
+		}
+		date, err := time.Parse(DateLayout, s)
+		if err != nil {
+			return nil, fmt.Errorf("must be a date in YYYY-MM-DD format")
+		}
+		return date, nil
+	case "radio_group", "dropdown":
+		s := fmt.Sprint(value)
+		for _, option := range f.Options {
+			if option.Value == s {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("must be one of the listed options")
+	default:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be text")
+		}
+		return s, nil
+	}
+}
+
+func (f Field) check(rule model.ValidationRule, value interface{}) (bool, error) {
+	switch rule.Type {
+	case "required":
+		return true, nil
+	case "min", "max":
+		cmp, err := compare(value, rule.Value)
+		if err != nil {
+			return false, err
+		}
+		if rule.Type == "min" {
+			return cmp >= 0, nil
+		}
+		return cmp <= 0, nil
+	case "minLength", "maxLength":
+		s, ok := value.(string)
+		if !ok {
+			return false, fmt.Errorf("%s only applies to text", rule.Type)
+		}
+		limit, err := toFloat(rule.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s rule value %v", rule.Type, rule.Value)
+		}
+		length := float64(utf8.RuneCountInString(s))
+		if rule.Type == "minLength" {
+			return length >= limit, nil
+		}
+		return length <= limit, nil
+	case "pattern":
+		s, ok := value.(string)
+		if !ok {
+			return false, fmt.Errorf("pattern only applies to text")
+		}
+		pattern, ok := rule.Value.(string)
+		if !ok {
+			return false, fmt.Errorf("invalid pattern rule value %v", rule.Value)
+		}
+		// Anchored like the HTML pattern attribute.
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q", pattern)
+		}
+		return re.MatchString(s), nil
+	default:
+		return false, fmt.Errorf("unknown rule %q", rule.Type)
+	}
+}
+
+// compare returns -1, 0 or 1 comparing a coerced field value with a rule bound.
+func compare(value, bound interface{}) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		b, err := toFloat(bound)
+		if err != nil {
+			return 0, fmt.Errorf("invalid numeric bound %v", bound)
+		}
+		return compareFloats(v, b), nil
+	case time.Time:
+		s, _ := bound.(string)
+		b, err := time.Parse(DateLayout, s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid date bound %v", bound)
+		}
+		return v.Compare(b), nil
+	default:
+		return 0, fmt.Errorf("min and max only apply to numbers and dates")
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("not a number: %v", value)
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "encoding/json"
+
 type DataSource struct {
 	Type       string                   `json:"type"` // "api", "static", "database"
 	URL        *string                  `json:"url"`
@@ -18,46 +20,150 @@ type PaginationConfig struct {
 	PageParam   *string `json:"pageParam"`
 }
 
+// ItemTemplate renders each data source item with the layout in Templates
+// keyed by the item's component_type, falling back to the template named by
+// Default, or to Layout when Default is empty.
 type ItemTemplate struct {
-	Type    string                  `json:"type"`
-	Layout  ComponentNode           `json:"layout"`
-	Actions map[string]ActionConfig `json:"actions"`
+	Type      string                   `json:"type"`
+	Layout    ComponentNode            `json:"layout"`
+	Actions   map[string]ActionConfig  `json:"actions"`
+	Templates map[string]ComponentNode `json:"templates,omitempty"`
+	Default   string                   `json:"default,omitempty"`
+}
+
+// MarshalJSON omits Layout when it is unset, as it is for lists that only
+// render items through Templates.
+func (t ItemTemplate) MarshalJSON() ([]byte, error) {
+	type itemTemplate ItemTemplate
+	encoded := struct {
+		itemTemplate
+		Layout *ComponentNode `json:"layout,omitempty"`
+	}{itemTemplate: itemTemplate(t)}
+	if t.Layout.Type != "" {
+		encoded.Layout = &t.Layout
+	}
+	return json.Marshal(encoded)
 }
 
+// ActionConfig describes what the client does when an action fires.
+// submit_form collects Fields and sends them to Endpoint, then runs OnSuccess
+// or OnError, typically a navigation, show_message or replace_screen action.
+// api_call sends Body with {{placeholders}} bound to the acting item's data.
+// Either Endpoint or EndpointKey, a key into DataConfig.APIEndpoints, is set.
+// An api_call with an ActionID is dispatched by a server-side registry and
+// sends {"actionId", "screenId", "params", "body"} instead of the bare body.
+//
+// Composite actions nest other actions: sequence runs Actions in order and
+// stops at the first failure, parallel starts them together, conditional runs
+// Then or Else depending on Condition, and confirm shows Confirm before
+// running Then. Conditions compare state.*, item.* and form.* values with
+// literals using ==, !=, <, <=, >, >=, &&, || and !.
 type ActionConfig struct {
-	Type        string            `json:"type"`
-	Destination *string           `json:"destination"`
-	Params      map[string]string `json:"params"`
+	Type        string                 `json:"type"` // "navigation", "api_call", "submit_form", "show_message", "replace_screen", "retry", "analytics", "sequence", "parallel", "conditional", "confirm"
+	Destination *string                `json:"destination"`
+	Params      map[string]string      `json:"params"`
+	ActionID    *string                `json:"actionId,omitempty"`
+	Fields      []string               `json:"fields,omitempty"`
+	Endpoint    *string                `json:"endpoint,omitempty"`
+	EndpointKey *string                `json:"endpointKey,omitempty"`
+	Method      *string                `json:"method,omitempty"`
+	Headers     map[string]string      `json:"headers,omitempty"`
+	Body        map[string]interface{} `json:"body,omitempty"`
+	Optimistic  *OptimisticUpdate      `json:"optimistic,omitempty"`
+	Message     *string                `json:"message,omitempty"`
+	OnSuccess   *ActionConfig          `json:"onSuccess,omitempty"`
+	OnError     *ActionConfig          `json:"onError,omitempty"`
+	Event       *string                `json:"event,omitempty"`
+	Actions     []ActionConfig         `json:"actions,omitempty"`
+	Condition   *string                `json:"condition,omitempty"`
+	Then        *ActionConfig          `json:"then,omitempty"`
+	Else        *ActionConfig          `json:"else,omitempty"`
+	Confirm     *ConfirmConfig         `json:"confirm,omitempty"`
+}
+
+type ConfirmConfig struct {
+	Title       *string `json:"title"`
+	Message     string  `json:"message"`
+	ConfirmText string  `json:"confirmText"`
+	CancelText  string  `json:"cancelText"`
+}
+
+// OptimisticUpdate lists properties the client applies to the acting
+// component as soon as an api_call starts and reverts if the call fails.
+type OptimisticUpdate struct {
+	Properties map[string]interface{} `json:"properties"`
 }
 
 type LoadingState struct {
-	Type  string `json:"type"`
-	Count *int   `json:"count"`
+	Type   string         `json:"type"` // "spinner", "shimmer", "skeleton"
+	Count  *int           `json:"count"`
+	Layout *ComponentNode `json:"layout,omitempty"`
 }
 
 type ModifierConfig struct {
-	Padding       *int            `json:"padding"`
+	Padding       *Dimension      `json:"padding"`
 	FillMaxSize   *bool           `json:"fillMaxSize"`
 	FillMaxWidth  *bool           `json:"fillMaxWidth"`
-	Width         *int            `json:"width"`
-	Height        *int            `json:"height"`
+	Width         *Dimension      `json:"width"`
+	Height        *Dimension      `json:"height"`
 	Weight        *float32        `json:"weight"`
-	PaddingStart  *int            `json:"paddingStart"`
-	PaddingTop    *int            `json:"paddingTop"`
-	PaddingEnd    *int            `json:"paddingEnd"`
-	PaddingBottom *int            `json:"paddingBottom"`
+	PaddingStart  *Dimension      `json:"paddingStart"`
+	PaddingTop    *Dimension      `json:"paddingTop"`
+	PaddingEnd    *Dimension      `json:"paddingEnd"`
+	PaddingBottom *Dimension      `json:"paddingBottom"`
 	Gradient      *GradientConfig `json:"gradient"`
+	Background    *string         `json:"background,omitempty"`
+	Border        *BorderConfig   `json:"border,omitempty"`
+	Shape         *ShapeConfig    `json:"shape,omitempty"`
+	Shadow        *ShadowConfig   `json:"shadow,omitempty"`
+	Alpha         *float32        `json:"alpha,omitempty"`
+	Alignment     *Alignment      `json:"alignment,omitempty"`
+	ZIndex        *float32        `json:"zIndex,omitempty"`
+	MinWidth      *Dimension      `json:"minWidth,omitempty"`
+	MaxWidth      *Dimension      `json:"maxWidth,omitempty"`
+	MinHeight     *Dimension      `json:"minHeight,omitempty"`
+	MaxHeight     *Dimension      `json:"maxHeight,omitempty"`
+	AspectRatio   *float32        `json:"aspectRatio,omitempty"`
+	OffsetX       *Dimension      `json:"offsetX,omitempty"`
+	OffsetY       *Dimension      `json:"offsetY,omitempty"`
+}
+
+type BorderConfig struct {
+	Width  Dimension  `json:"width"`
+	Color  string     `json:"color"`
+	Radius *Dimension `json:"radius"`
+}
+
+type ShapeConfig struct {
+	Type         string     `json:"type"` // "rectangle", "rounded", "circle", "pill"
+	CornerRadius *Dimension `json:"cornerRadius"`
+}
+
+type ShadowConfig struct {
+	Elevation int        `json:"elevation"`
+	Color     *string    `json:"color"`
+	OffsetX   *Dimension `json:"offsetX"`
+	OffsetY   *Dimension `json:"offsetY"`
+	Blur      *Dimension `json:"blur"`
 }
 
 type GradientConfig struct {
-	Type   string   `json:"type"`
-	Colors []string `json:"colors"`
-	Angle  *float32 `json:"angle"`
-	StartX *float32 `json:"startX"`
-	StartY *float32 `json:"startY"`
-	EndX   *float32 `json:"endX"`
-	EndY   *float32 `json:"endY"`
-	Radius *float32 `json:"radius"`
+	Type      string    `json:"type"` // "linear", "radial", "sweep"
+	Colors    []string  `json:"colors"`
+	Positions []float32 `json:"positions,omitempty"`
+	Angle     *float32  `json:"angle"`
+	StartX    *float32  `json:"startX"`
+	StartY    *float32  `json:"startY"`
+	EndX      *float32  `json:"endX"`
+	EndY      *float32  `json:"endY"`
+	Radius    *float32  `json:"radius"`
+	CenterX   *float32  `json:"centerX,omitempty"`
+	CenterY   *float32  `json:"centerY,omitempty"`
+}
+
+type ColorStop struct {
+	Color    string   `json:"color"`
+	Position *float32 `json:"position"`
 }
 
 type ComponentNode struct {
@@ -65,15 +171,20 @@ type ComponentNode struct {
 	ID              *string                 `json:"id"`
 	Properties      map[string]interface{}  `json:"properties"`
 	Modifier        *ModifierConfig         `json:"modifier"`
+	ModifierChain   []ModifierConfig        `json:"modifierChain,omitempty"`
 	Children        []ComponentNode         `json:"children"`
 	Actions         map[string]ActionConfig `json:"actions"`
-	Arrangement     *string                 `json:"arrangement"`
+	Arrangement     *Arrangement            `json:"arrangement"`
+	Alignment       *Alignment              `json:"alignment,omitempty"`
 	Columns         *int                    `json:"columns"`
+	MinCellWidth    *Dimension              `json:"minCellWidth,omitempty"`
+	Spans           map[string]int          `json:"spans,omitempty"`
 	DataSource      *DataSource             `json:"dataSource"`
 	ItemTemplate    *ItemTemplate           `json:"itemTemplate"`
 	LoadingTemplate *LoadingState           `json:"loadingTemplate"`
 	EmptyTemplate   *ComponentNode          `json:"emptyTemplate"`
 	ErrorTemplate   *ComponentNode          `json:"errorTemplate"`
+	Sections        *SectionConfig          `json:"sections,omitempty"`
 }
 
 type ScreenLayout struct {
@@ -83,10 +194,13 @@ type ScreenLayout struct {
 }
 
 type ThemeConfig struct {
-	PrimaryColor    string `json:"primaryColor"`
-	SecondaryColor  string `json:"secondaryColor"`
-	BackgroundColor string `json:"backgroundColor"`
-	TextColor       string `json:"textColor"`
+	PrimaryColor    string                    `json:"primaryColor"`
+	SecondaryColor  string                    `json:"secondaryColor"`
+	BackgroundColor string                    `json:"backgroundColor"`
+	TextColor       string                    `json:"textColor"`
+	Typography      Typography                `json:"typography,omitempty"`
+	Spacing         map[string]Dimension      `json:"spacing,omitempty"`
+	Gradients       map[string]GradientConfig `json:"gradients,omitempty"`
 }
 
 type DataConfig struct {
@@ -115,14 +229,14 @@ type ChartSeries struct {
 }
 
 type ChartConfig struct {
-	Title      *string  `json:"title"`
-	Subtitle   *string  `json:"subtitle"`
-	ShowLegend *bool    `json:"showLegend"`
-	ShowGrid   *bool    `json:"showGrid"`
-	ShowLabels *bool    `json:"showLabels"`
-	ShowValues *bool    `json:"showValues"`
-	Animated   *bool    `json:"animated"`
-	Colors     []string `json:"colors"`
-	Height     *int     `json:"height"`
-	Width      *int     `json:"width"`
+	Title      *string    `json:"title"`
+	Subtitle   *string    `json:"subtitle"`
+	ShowLegend *bool      `json:"showLegend"`
+	ShowGrid   *bool      `json:"showGrid"`
+	ShowLabels *bool      `json:"showLabels"`
+	ShowValues *bool      `json:"showValues"`
+	Animated   *bool      `json:"animated"`
+	Colors     []string   `json:"colors"`
+	Height     *Dimension `json:"height"`
+	Width      *Dimension `json:"width"`
 }
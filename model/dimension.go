@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Unit string
+
+const (
+	Dp      Unit = "dp"
+	Sp      Unit = "sp"
+	Px      Unit = "px"
+	Percent Unit = "%"
+	Wrap    Unit = "wrap"
+	Fill    Unit = "fill"
+)
+
+// Dimension is serialized as a single string such as "16dp", "50%" or
+// "wrap" so that web and native clients cannot disagree on the unit.
+type Dimension struct {
+	Value float32
+	Unit  Unit
+}
+
+func (d Dimension) String() string {
+	if d.Unit == Wrap || d.Unit == Fill {
+		return string(d.Unit)
+	}
+	return strconv.FormatFloat(float64(d.Value), 'f', -1, 32) + string(d.Unit)
+}
+
+func (d Dimension) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON also accepts the bare numbers emitted before dimensions had
+// units; those were always meant as dp.
+func (d *Dimension) UnmarshalJSON(data []byte) error {
+	var number float32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*d = Dimension{Value: number, Unit: Dp}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("dimension must be a number or string: %s", data)
+	}
+	parsed, err := ParseDimension(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func ParseDimension(s string) (Dimension, error) {
+	value := strings.TrimSpace(s)
+	switch Unit(value) {
+	case Wrap, Fill:
+		return Dimension{Unit: Unit(value)}, nil
+	}
+	for _, unit := range []Unit{Dp, Sp, Px, Percent} {
+		if strings.HasSuffix(value, string(unit)) {
+			number, err := strconv.ParseFloat(strings.TrimSuffix(value, string(unit)), 32)
+			if err != nil {
+				return Dimension{}, fmt.Errorf("invalid dimension %q", s)
+			}
+			return Dimension{Value: float32(number), Unit: unit}, nil
+		}
+	}
+	number, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return Dimension{}, fmt.Errorf("invalid dimension %q", s)
+	}
+	return Dimension{Value: float32(number), Unit: Dp}, nil
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDimensionJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		dimension Dimension
+		json      string
+	}{
+		{"Dp", Dimension{Value: 16, Unit: Dp}, `"16dp"`},
+		{"Sp", Dimension{Value: 14.5, Unit: Sp}, `"14.5sp"`},
+		{"Px", Dimension{Value: 1, Unit: Px}, `"1px"`},
+		{"Percent", Dimension{Value: 50, Unit: Percent}, `"50%"`},
+		{"Wrap", Dimension{Unit: Wrap}, `"wrap"`},
+		{"Fill", Dimension{Unit: Fill}, `"fill"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytes, err := json.Marshal(tt.dimension)
+			if err != nil {
+				t.Fatalf("Failed to marshal dimension: %v", err)
+			}
+			if string(bytes) != tt.json {
+				t.Errorf("Marshal = %s, want %s", bytes, tt.json)
+			}
+
+			var decoded Dimension
+			if err := json.Unmarshal(bytes, &decoded); err != nil {
+				t.Fatalf("Failed to unmarshal dimension: %v", err)
+			}
+			if decoded != tt.dimension {
+				t.Errorf("Round trip = %+v, want %+v", decoded, tt.dimension)
+			}
+		})
+	}
+}
+
+func TestLegacyIntModifier(t *testing.T) {
+	var modifier ModifierConfig
+	if err := json.Unmarshal([]byte(`{"padding":16,"width":"50%","height":"wrap"}`), &modifier); err != nil {
+		t.Fatalf("Failed to decode legacy modifier: %v", err)
+	}
+	if *modifier.Padding != (Dimension{Value: 16, Unit: Dp}) {
+		t.Errorf("Expected legacy int padding to decode as dp, got %s", modifier.Padding)
+	}
+	if *modifier.Width != (Dimension{Value: 50, Unit: Percent}) {
+		t.Errorf("Unexpected width %s", modifier.Width)
+	}
+	if modifier.Height.Unit != Wrap {
+		t.Errorf("Unexpected height %s", modifier.Height)
+	}
+
+	var styled ModifierConfig
+	if err := json.Unmarshal([]byte(`{"border":{"width":1,"color":"#000","radius":"4px"},"shape":{"type":"rounded","cornerRadius":12},"shadow":{"elevation":2,"blur":6}}`), &styled); err != nil {
+		t.Fatalf("Failed to decode legacy border, shape and shadow: %v", err)
+	}
+	if styled.Border.Width != (Dimension{Value: 1, Unit: Dp}) || *styled.Border.Radius != (Dimension{Value: 4, Unit: Px}) {
+		t.Errorf("Unexpected border %+v", styled.Border)
+	}
+	if *styled.Shape.CornerRadius != (Dimension{Value: 12, Unit: Dp}) || *styled.Shadow.Blur != (Dimension{Value: 6, Unit: Dp}) {
+		t.Errorf("Unexpected shape %+v or shadow %+v", styled.Shape, styled.Shadow)
+	}
+
+	if err := json.Unmarshal([]byte(`{"padding":"16em"}`), &modifier); err == nil {
+		t.Error("Expected an unknown unit to be rejected")
+	}
+}
@@ -0,0 +1,14 @@
+package model
+
+// ValidationRule is evaluated identically by clients, before submitting, and
+// by the server when the payload arrives.
+type ValidationRule struct {
+	Type    string      `json:"type"` // "required", "min", "max", "minLength", "maxLength", "pattern"
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+}
+
+type FieldOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import "strings"
+
+type Alignment string
+
+const (
+	AlignTopStart     Alignment = "topStart"
+	AlignTopCenter    Alignment = "topCenter"
+	AlignTopEnd       Alignment = "topEnd"
+	AlignCenterStart  Alignment = "centerStart"
+	AlignCenter       Alignment = "center"
+	AlignCenterEnd    Alignment = "centerEnd"
+	AlignBottomStart  Alignment = "bottomStart"
+	AlignBottomCenter Alignment = "bottomCenter"
+	AlignBottomEnd    Alignment = "bottomEnd"
+)
+
+// Cross-axis alignment of the children of a Row (vertical) or Column (horizontal).
+const (
+	AlignTop                Alignment = "top"
+	AlignCenterVertically   Alignment = "centerVertically"
+	AlignBottom             Alignment = "bottom"
+	AlignStart              Alignment = "start"
+	AlignCenterHorizontally Alignment = "centerHorizontally"
+	AlignEnd                Alignment = "end"
+)
+
+type Arrangement string
+
+const (
+	ArrangeStart  Arrangement = "start"
+	ArrangeEnd    Arrangement = "end"
+	ArrangeCenter Arrangement = "center"
+	SpaceBetween  Arrangement = "spaceBetween"
+	SpaceAround   Arrangement = "spaceAround"
+	SpaceEvenly   Arrangement = "spaceEvenly"
+)
+
+const spacedByPrefix = "spacedBy("
+
+func SpacedBy(space Dimension) Arrangement {
+	return Arrangement(spacedByPrefix + space.String() + ")")
+}
+
+// Spacing returns the gap of a SpacedBy arrangement.
+func (a Arrangement) Spacing() (Dimension, bool) {
+	value := string(a)
+	if !strings.HasPrefix(value, spacedByPrefix) || !strings.HasSuffix(value, ")") {
+		return Dimension{}, false
+	}
+	space, err := ParseDimension(value[len(spacedByPrefix) : len(value)-1])
+	return space, err == nil
+}
+
+// Arranged returns a copy of the node with its main-axis arrangement set, so
+// layouts read as Row(...).Arranged(SpaceBetween).
+func (n ComponentNode) Arranged(arrangement Arrangement) ComponentNode {
+	n.Arrangement = &arrangement
+	return n
+}
+
+// WithChildAlignment returns a copy of the node with the cross-axis alignment
+// of its children set. To position the node itself within its parent, use
+// ui.Aligned.
+func (n ComponentNode) WithChildAlignment(alignment Alignment) ComponentNode {
+	n.Alignment = &alignment
+	return n
+}
+
+// FullLineSpan makes an item type in a grid span every column, which is the
+// only way to express a full-width header in an adaptive grid.
+const FullLineSpan = -1
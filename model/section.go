@@ -0,0 +1,18 @@
+package model
+
+type SectionConfig struct {
+	GroupBy        string        `json:"groupBy"`
+	HeaderTemplate ComponentNode `json:"headerTemplate"`
+	StickyHeaders  bool          `json:"stickyHeaders"`
+}
+
+// SectionPage is the response body API-backed sectioned lists expect for
+// each page. Items must be ordered so that every section is contiguous;
+// ContinuesPreviousSection tells the client that the first items belong to
+// the section the previous page ended with, so it must not repeat the header.
+type SectionPage struct {
+	Items                    []map[string]interface{} `json:"items"`
+	ContinuesPreviousSection bool                     `json:"continuesPreviousSection"`
+	LastSection              interface{}              `json:"lastSection"`
+	HasMore                  bool                     `json:"hasMore"`
+}
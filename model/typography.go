@@ -0,0 +1,29 @@
+package model
+
+type TextStyleName string
+
+const (
+	Headline1  TextStyleName = "headline1"
+	Headline2  TextStyleName = "headline2"
+	Headline3  TextStyleName = "headline3"
+	Headline4  TextStyleName = "headline4"
+	Headline5  TextStyleName = "headline5"
+	Headline6  TextStyleName = "headline6"
+	Subtitle1  TextStyleName = "subtitle1"
+	Subtitle2  TextStyleName = "subtitle2"
+	Body1      TextStyleName = "body1"
+	Body2      TextStyleName = "body2"
+	Caption    TextStyleName = "caption"
+	Overline   TextStyleName = "overline"
+	ButtonText TextStyleName = "button"
+)
+
+type TextStyle struct {
+	FontFamily    string   `json:"fontFamily"`
+	FontSize      float32  `json:"fontSize"`
+	FontWeight    int      `json:"fontWeight"`
+	LineHeight    *float32 `json:"lineHeight"`
+	LetterSpacing *float32 `json:"letterSpacing"`
+}
+
+type Typography map[TextStyleName]TextStyle
@@ -0,0 +1,191 @@
+package patch
+
+import (
+	"errors"
+	"github.com/nicholaspark09/ssr-go/model"
+	"sort"
+)
+
+// ErrNotPatchable is returned by Diff when the screens differ in a way no
+// patch can express, such as a changed title or theme, or a change under
+// nodes none of which have an ID. Send the whole screen instead.
+var ErrNotPatchable = errors.New("screens cannot be patched")
+
+// Diff computes a patch that turns old into new. Children are matched by ID
+// when every child has one and by position otherwise. A change that cannot
+// be described more precisely replaces the nearest enclosing node with an ID.
+func Diff(old, new model.ComponentScreen) (Patch, error) {
+	if old.Version != new.Version || old.Screen.ID != new.Screen.ID || old.Screen.Title != new.Screen.Title ||
+		!jsonEqual(old.Theme, new.Theme) || !jsonEqual(old.Data, new.Data) {
+		return nil, ErrNotPatchable
+	}
+
+	ops, replace := diffNode(old.Screen.Layout, new.Screen.Layout)
+	if replace {
+		return nil, ErrNotPatchable
+	}
+	return ops, nil
+}
+
+// diffNode returns the operations turning old into new, or replace=true when
+// the caller must replace an ancestor because old has no ID to target.
+func diffNode(old, new model.ComponentNode) (Patch, bool) {
+	if !sameID(old.ID, new.ID) || !jsonEqual(shape(old), shape(new)) {
+		return replaceOrBubble(old, new)
+	}
+
+	var ops Patch
+	if set, remove := diffProperties(old.Properties, new.Properties); len(set) > 0 || len(remove) > 0 {
+		if old.ID == nil {
+			return replaceOrBubble(old, new)
+		}
+		ops = append(ops, UpdateProperties(*old.ID, set, remove...))
+	}
+
+	if old.DataSource != nil {
+		appended, ok := appendedItems(old.DataSource.Items, new.DataSource.Items)
+		if !ok || (len(appended) > 0 && old.ID == nil) {
+			return replaceOrBubble(old, new)
+		}
+		if len(appended) > 0 {
+			ops = append(ops, AppendItems(*old.ID, appended...))
+		}
+	}
+
+	childOps, replace := diffChildren(old, new)
+	if replace {
+		return replaceOrBubble(old, new)
+	}
+	return append(ops, childOps...), false
+}
+
+func diffChildren(old, new model.ComponentNode) (Patch, bool) {
+	if old.ID != nil && allHaveIDs(old.Children) && allHaveIDs(new.Children) {
+		return diffChildrenByID(*old.ID, old.Children, new.Children)
+	}
+	if len(old.Children) != len(new.Children) {
+		return nil, true
+	}
+	var ops Patch
+	for i := range old.Children {
+		childOps, replace := diffNode(old.Children[i], new.Children[i])
+		if replace {
+			return nil, true
+		}
+		ops = append(ops, childOps...)
+	}
+	return ops, false
+}
+
+func diffChildrenByID(parent string, old, new []model.ComponentNode) (Patch, bool) {
+	newIndex := make(map[string]int, len(new))
+	for i, child := range new {
+		newIndex[*child.ID] = i
+	}
+	oldIndex := make(map[string]int, len(old))
+	for i, child := range old {
+		oldIndex[*child.ID] = i
+	}
+	if len(newIndex) != len(new) || len(oldIndex) != len(old) {
+		return nil, true
+	}
+
+	var ops Patch
+	var kept []int
+	for _, child := range old {
+		if index, ok := newIndex[*child.ID]; ok {
+			kept = append(kept, index)
+		} else {
+			ops = append(ops, RemoveChild(parent, *child.ID))
+		}
+	}
+	// Moving children is not an operation, so reordering replaces the parent.
+	if !sort.IntsAreSorted(kept) {
+		return nil, true
+	}
+
+	for i, child := range new {
+		if _, ok := oldIndex[*child.ID]; !ok {
+			ops = append(ops, InsertChild(parent, i, child))
+		}
+	}
+	for _, child := range new {
+		if i, ok := oldIndex[*child.ID]; ok {
+			childOps, replace := diffNode(old[i], child)
+			if replace {
+				return nil, true
+			}
+			ops = append(ops, childOps...)
+		}
+	}
+	return ops, false
+}
+
+func replaceOrBubble(old, new model.ComponentNode) (Patch, bool) {
+	if old.ID == nil {
+		return nil, true
+	}
+	return Patch{Replace(*old.ID, new)}, false
+}
+
+// shape is the node without the parts diffNode patches individually.
+func shape(node model.ComponentNode) model.ComponentNode {
+	node.Properties = nil
+	node.Children = nil
+	if node.DataSource != nil {
+		dataSource := *node.DataSource
+		dataSource.Items = nil
+		node.DataSource = &dataSource
+	}
+	return node
+}
+
+func diffProperties(old, new map[string]interface{}) (map[string]interface{}, []string) {
+	set := make(map[string]interface{})
+	var remove []string
+	for key, value := range new {
+		if oldValue, ok := old[key]; !ok || !jsonEqual(oldValue, value) {
+			set[key] = value
+		}
+	}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			remove = append(remove, key)
+		}
+	}
+	sort.Strings(remove)
+	if len(set) == 0 {
+		set = nil
+	}
+	return set, remove
+}
+
+// appendedItems returns the items new adds after old, or ok=false when new
+// does not start with old.
+func appendedItems(old, new []map[string]interface{}) ([]map[string]interface{}, bool) {
+	if len(new) < len(old) {
+		return nil, false
+	}
+	for i := range old {
+		if !jsonEqual(old[i], new[i]) {
+			return nil, false
+		}
+	}
+	return new[len(old):], true
+}
+
+func allHaveIDs(nodes []model.ComponentNode) bool {
+	for _, node := range nodes {
+		if node.ID == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func sameID(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,168 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+type Op string
+
+const (
+	OpReplace          Op = "replace"
+	OpUpdateProperties Op = "update_properties"
+	OpInsertChild      Op = "insert_child"
+	OpRemoveChild      Op = "remove_child"
+	OpAppendItems      Op = "append_items"
+)
+
+// Operation changes the node whose ComponentNode.ID equals Target. Only
+// nodes reachable through Children can be addressed; nodes inside item
+// templates are rendered once per item and have no single instance.
+type Operation struct {
+	Op         Op                       `json:"op"`
+	Target     string                   `json:"target"`
+	Node       *model.ComponentNode     `json:"node,omitempty"`       // replace, insert_child
+	Index      *int                     `json:"index,omitempty"`      // insert_child, appends when nil
+	Child      string                   `json:"child,omitempty"`      // remove_child
+	Properties map[string]interface{}   `json:"properties,omitempty"` // update_properties
+	Remove     []string                 `json:"remove,omitempty"`     // update_properties
+	Items      []map[string]interface{} `json:"items,omitempty"`      // append_items
+}
+
+type Patch []Operation
+
+func Replace(target string, node model.ComponentNode) Operation {
+	return Operation{Op: OpReplace, Target: target, Node: &node}
+}
+
+func UpdateProperties(target string, properties map[string]interface{}, remove ...string) Operation {
+	return Operation{Op: OpUpdateProperties, Target: target, Properties: properties, Remove: remove}
+}
+
+func InsertChild(parent string, index int, node model.ComponentNode) Operation {
+	return Operation{Op: OpInsertChild, Target: parent, Index: &index, Node: &node}
+}
+
+func AppendChild(parent string, node model.ComponentNode) Operation {
+	return Operation{Op: OpInsertChild, Target: parent, Node: &node}
+}
+
+func RemoveChild(parent, child string) Operation {
+	return Operation{Op: OpRemoveChild, Target: parent, Child: child}
+}
+
+func AppendItems(target string, items ...map[string]interface{}) Operation {
+	return Operation{Op: OpAppendItems, Target: target, Items: items}
+}
+
+// Apply returns a copy of screen with every operation applied in order.
+// The input screen is not modified.
+func Apply(screen model.ComponentScreen, p Patch) (model.ComponentScreen, error) {
+	screen.Screen.Layout = cloneNode(screen.Screen.Layout)
+	for i, op := range p {
+		if err := applyOperation(&screen.Screen.Layout, op); err != nil {
+			return model.ComponentScreen{}, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Target, err)
+		}
+	}
+	return screen, nil
+}
+
+func applyOperation(root *model.ComponentNode, op Operation) error {
+	node := findNode(root, op.Target)
+	if node == nil {
+		return fmt.Errorf("no node with ID %q", op.Target)
+	}
+
+	switch op.Op {
+	case OpReplace:
+		if op.Node == nil {
+			return fmt.Errorf("replace has no node")
+		}
+		*node = cloneNode(*op.Node)
+	case OpUpdateProperties:
+		if node.Properties == nil {
+			node.Properties = make(map[string]interface{})
+		}
+		for key, value := range op.Properties {
+			node.Properties[key] = value
+		}
+		for _, key := range op.Remove {
+			delete(node.Properties, key)
+		}
+	case OpInsertChild:
+		if op.Node == nil {
+			return fmt.Errorf("insert_child has no node")
+		}
+		index := len(node.Children)
+		if op.Index != nil {
+			index = *op.Index
+		}
+		if index < 0 || index > len(node.Children) {
+			return fmt.Errorf("index %d out of range for %d children", index, len(node.Children))
+		}
+		children := make([]model.ComponentNode, 0, len(node.Children)+1)
+		children = append(children, node.Children[:index]...)
+		children = append(children, cloneNode(*op.Node))
+		node.Children = append(children, node.Children[index:]...)
+	case OpRemoveChild:
+		for i, child := range node.Children {
+			if child.ID != nil && *child.ID == op.Child {
+				node.Children = append(node.Children[:i:i], node.Children[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("no child with ID %q", op.Child)
+	case OpAppendItems:
+		if node.DataSource == nil {
+			return fmt.Errorf("node has no data source")
+		}
+		node.DataSource.Items = append(node.DataSource.Items, op.Items...)
+	default:
+		return fmt.Errorf("unknown op %q", op.Op)
+	}
+	return nil
+}
+
+func findNode(node *model.ComponentNode, id string) *model.ComponentNode {
+	if node.ID != nil && *node.ID == id {
+		return node
+	}
+	for i := range node.Children {
+		if found := findNode(&node.Children[i], id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// cloneNode copies everything Apply mutates: the children tree, property
+// maps and data source items.
+func cloneNode(node model.ComponentNode) model.ComponentNode {
+	if node.Properties != nil {
+		properties := make(map[string]interface{}, len(node.Properties))
+		for key, value := range node.Properties {
+			properties[key] = value
+		}
+		node.Properties = properties
+	}
+	if node.Children != nil {
+		children := make([]model.ComponentNode, len(node.Children))
+		for i, child := range node.Children {
+			children[i] = cloneNode(child)
+		}
+		node.Children = children
+	}
+	if node.DataSource != nil {
+		dataSource := *node.DataSource
+		dataSource.Items = append([]map[string]interface{}(nil), node.DataSource.Items...)
+		node.DataSource = &dataSource
+	}
+	return node
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
@@ -0,0 +1,159 @@
+package patch
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"testing"
+)
+
+func counterScreen(count int, todos []string, items ...map[string]interface{}) model.ComponentScreen {
+	var todoNodes []model.ComponentNode
+	for _, todo := range todos {
+		todoNodes = append(todoNodes, ui.NewComponent("text").WithID(todo).WithProperty("text", todo).Build())
+	}
+	return ui.NewScreen("counter", "Counter", "1.0").
+		WithLayout(ui.NewComponent("column").WithID("root").WithChildren(
+			ui.NewComponent("text").WithID("count").WithProperty("text", count).Build(),
+			ui.NewComponent("column").WithID("todos").WithChildren(todoNodes...).Build(),
+			ui.NewComponent("lazy_column").WithID("feed").
+				WithDataSource(ui.StaticDataSource(items)).
+				WithItemTemplate(model.ItemTemplate{Type: "post", Layout: ui.Text("{{title}}")}).
+				Build(),
+			ui.Column(ui.Text("Footer")),
+		).Build()).
+		Build()
+}
+
+func screenJSON(t *testing.T, screen model.ComponentScreen) string {
+	t.Helper()
+	data, err := json.Marshal(screen)
+	if err != nil {
+		t.Fatalf("Failed to marshal screen: %v", err)
+	}
+	return string(data)
+}
+
+func TestDiffAndApply(t *testing.T) {
+	post := func(title string) map[string]interface{} { return map[string]interface{}{"title": title} }
+
+	tests := []struct {
+		name    string
+		old     model.ComponentScreen
+		new     model.ComponentScreen
+		wantOps []Op
+	}{
+		{
+			name:    "unchanged",
+			old:     counterScreen(1, []string{"a"}),
+			new:     counterScreen(1, []string{"a"}),
+			wantOps: nil,
+		},
+		{
+			name:    "counter",
+			old:     counterScreen(1, []string{"a"}),
+			new:     counterScreen(2, []string{"a"}),
+			wantOps: []Op{OpUpdateProperties},
+		},
+		{
+			name:    "insert and remove children",
+			old:     counterScreen(1, []string{"a", "b", "c"}),
+			new:     counterScreen(1, []string{"a", "d", "c", "e"}),
+			wantOps: []Op{OpRemoveChild, OpInsertChild, OpInsertChild},
+		},
+		{
+			name:    "reorder replaces parent",
+			old:     counterScreen(1, []string{"a", "b"}),
+			new:     counterScreen(1, []string{"b", "a"}),
+			wantOps: []Op{OpReplace},
+		},
+		{
+			name:    "append items",
+			old:     counterScreen(1, nil, post("one")),
+			new:     counterScreen(1, nil, post("one"), post("two"), post("three")),
+			wantOps: []Op{OpAppendItems},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Diff(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("Diff returned error: %v", err)
+			}
+			if len(p) != len(tt.wantOps) {
+				t.Fatalf("Expected ops %v, got %+v", tt.wantOps, p)
+			}
+			for i, op := range p {
+				if op.Op != tt.wantOps[i] {
+					t.Errorf("Op %d = %s, want %s", i, op.Op, tt.wantOps[i])
+				}
+			}
+
+			patched, err := Apply(tt.old, p)
+			if err != nil {
+				t.Fatalf("Apply returned error: %v", err)
+			}
+			if got, want := screenJSON(t, patched), screenJSON(t, tt.new); got != want {
+				t.Errorf("Patched screen differs:\n got %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffReplacesNearestNodeWithID(t *testing.T) {
+	old := counterScreen(1, nil)
+	new := counterScreen(1, nil)
+	new.Screen.Layout.Children[3] = ui.Column(ui.Text("New footer"))
+
+	p, err := Diff(old, new)
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	if len(p) != 1 || p[0].Op != OpReplace || p[0].Target != "root" {
+		t.Errorf("Expected root to be replaced, got %+v", p)
+	}
+
+	new.Screen.Title = "Renamed"
+	if _, err := Diff(old, new); err != ErrNotPatchable {
+		t.Errorf("Expected ErrNotPatchable for a title change, got %v", err)
+	}
+}
+
+func TestDiffWithGeneratedIDs(t *testing.T) {
+	home := func(count string) model.ComponentScreen {
+		screen := ui.NewScreen("home", "Home", "1.0").WithLayout(ui.Column(ui.Text(count), ui.Text("Footer"))).Build()
+		tree.AssignScreenIDs(&screen)
+		return screen
+	}
+
+	p, err := Diff(home("Count: 1"), home("Count: 2"))
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	if len(p) != 1 || p[0].Op != OpUpdateProperties || p[0].Target != "home-column_0-text_0" {
+		t.Errorf("Expected a property update on the generated ID, got %+v", p)
+	}
+}
+
+func TestApplyDoesNotModifyInput(t *testing.T) {
+	old := counterScreen(1, []string{"a"})
+	before := screenJSON(t, old)
+
+	_, err := Apply(old, Patch{
+		UpdateProperties("count", map[string]interface{}{"text": 5}),
+		RemoveChild("todos", "a"),
+		AppendItems("feed", map[string]interface{}{"title": "new"}),
+	})
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if after := screenJSON(t, old); after != before {
+		t.Errorf("Apply modified its input:\nbefore %s\n after %s", before, after)
+	}
+
+	if _, err := Apply(old, Patch{RemoveChild("missing", "a")}); err == nil {
+		t.Error("Expected an error for an unknown target")
+	}
+}
@@ -0,0 +1,305 @@
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"strconv"
+	"strings"
+)
+
+type ThemeMapping struct {
+	PrimaryColor    string
+	SecondaryColor  string
+	BackgroundColor string
+	TextColor       string
+	TypographyGroup string
+	SpacingGroup    string
+	GradientGroup   string
+}
+
+func DefaultThemeMapping() ThemeMapping {
+	return ThemeMapping{
+		PrimaryColor:    "color.brand.primary",
+		SecondaryColor:  "color.brand.secondary",
+		BackgroundColor: "color.background",
+		TextColor:       "color.text",
+		TypographyGroup: "typography",
+		SpacingGroup:    "spacing",
+		GradientGroup:   "gradient",
+	}
+}
+
+// Theme builds a ThemeConfig from the set. Empty mapping entries are skipped,
+// so a token file that only defines colors still produces a usable theme.
+func (s *Set) Theme(mapping ThemeMapping) (model.ThemeConfig, error) {
+	var theme model.ThemeConfig
+	colors := []struct {
+		path   string
+		target *string
+	}{
+		{mapping.PrimaryColor, &theme.PrimaryColor},
+		{mapping.SecondaryColor, &theme.SecondaryColor},
+		{mapping.BackgroundColor, &theme.BackgroundColor},
+		{mapping.TextColor, &theme.TextColor},
+	}
+	for _, c := range colors {
+		if c.path == "" {
+			continue
+		}
+		color, err := s.Color(c.path)
+		if err != nil {
+			return model.ThemeConfig{}, err
+		}
+		*c.target = color
+	}
+
+	var err error
+	if mapping.TypographyGroup != "" {
+		if theme.Typography, err = s.Typography(mapping.TypographyGroup); err != nil {
+			return model.ThemeConfig{}, err
+		}
+	}
+	if mapping.SpacingGroup != "" {
+		if theme.Spacing, err = s.Spacing(mapping.SpacingGroup); err != nil {
+			return model.ThemeConfig{}, err
+		}
+	}
+	if mapping.GradientGroup != "" {
+		if theme.Gradients, err = s.Gradients(mapping.GradientGroup); err != nil {
+			return model.ThemeConfig{}, err
+		}
+	}
+	return theme, nil
+}
+
+func (s *Set) Color(path string) (string, error) {
+	value, err := s.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+	color, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("token %s is not a color: %v", path, value)
+	}
+	return color, nil
+}
+
+func (s *Set) Dimension(path string) (float64, error) {
+	value, err := s.Resolve(path)
+	if err != nil {
+		return 0, err
+	}
+	dimension, err := parseDimension(value)
+	if err != nil {
+		return 0, fmt.Errorf("token %s: %w", path, err)
+	}
+	return dimension, nil
+}
+
+// Typography converts every typography token under group into a text style
+// named after the token's last path segment, e.g. typography.headline1.
+func (s *Set) Typography(group string) (model.Typography, error) {
+	typography := model.Typography{}
+	for _, path := range s.PathsUnder(group) {
+		value, err := s.Resolve(path)
+		if err != nil {
+			return nil, err
+		}
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		style, err := parseTextStyle(fields)
+		if err != nil {
+			return nil, fmt.Errorf("token %s: %w", path, err)
+		}
+		typography[model.TextStyleName(lastSegment(path))] = style
+	}
+	return typography, nil
+}
+
+// Spacing converts dimension tokens to dp: a design-tool pixel is a
+// density-independent pixel on every client.
+func (s *Set) Spacing(group string) (map[string]model.Dimension, error) {
+	spacing := map[string]model.Dimension{}
+	for _, path := range s.PathsUnder(group) {
+		dimension, err := s.Dimension(path)
+		if err != nil {
+			return nil, err
+		}
+		spacing[strings.TrimPrefix(path, group+".")] = model.Dimension{Value: float32(dimension), Unit: model.Dp}
+	}
+	return spacing, nil
+}
+
+func (s *Set) Gradients(group string) (map[string]model.GradientConfig, error) {
+	gradients := map[string]model.GradientConfig{}
+	for _, path := range s.PathsUnder(group) {
+		value, err := s.Resolve(path)
+		if err != nil {
+			return nil, err
+		}
+		stops, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("token %s is not a gradient: %v", path, value)
+		}
+		gradient := model.GradientConfig{Type: "linear"}
+		positions := make([]float32, 0, len(stops))
+		for _, raw := range stops {
+			stop, ok := raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("token %s has an invalid gradient stop: %v", path, raw)
+			}
+			color, ok := stop["color"].(string)
+			if !ok {
+				return nil, fmt.Errorf("token %s has a gradient stop without a color", path)
+			}
+			gradient.Colors = append(gradient.Colors, color)
+			if raw, ok := stop["position"]; ok {
+				position, err := parseDimension(raw)
+				if err != nil {
+					return nil, fmt.Errorf("token %s: position: %w", path, err)
+				}
+				positions = append(positions, float32(position))
+			}
+		}
+		if len(positions) == len(gradient.Colors) {
+			gradient.Positions = positions
+		}
+		gradients[strings.TrimPrefix(path, group+".")] = gradient
+	}
+	return gradients, nil
+}
+
+func parseTextStyle(fields map[string]interface{}) (model.TextStyle, error) {
+	var style model.TextStyle
+	switch family := fields["fontFamily"].(type) {
+	case string:
+		style.FontFamily = family
+	case []interface{}:
+		if len(family) > 0 {
+			style.FontFamily = fmt.Sprint(family[0])
+		}
+	}
+
+	if raw, ok := fields["fontSize"]; ok {
+		size, err := parseDimension(raw)
+		if err != nil {
+			return model.TextStyle{}, fmt.Errorf("fontSize: %w", err)
+		}
+		style.FontSize = float32(size)
+	}
+	if raw, ok := fields["fontWeight"]; ok {
+		weight, err := parseFontWeight(raw)
+		if err != nil {
+			return model.TextStyle{}, err
+		}
+		style.FontWeight = weight
+	}
+	if raw, ok := fields["lineHeight"]; ok {
+		lineHeight, err := parseDimension(raw)
+		if err != nil {
+			return model.TextStyle{}, fmt.Errorf("lineHeight: %w", err)
+		}
+		// A unitless line height is a multiplier of the font size.
+		if isUnitless(raw) {
+			lineHeight *= float64(style.FontSize)
+		}
+		v := float32(lineHeight)
+		style.LineHeight = &v
+	}
+	if raw, ok := fields["letterSpacing"]; ok {
+		letterSpacing, err := parseDimension(raw)
+		if err != nil {
+			return model.TextStyle{}, fmt.Errorf("letterSpacing: %w", err)
+		}
+		v := float32(letterSpacing)
+		style.LetterSpacing = &v
+	}
+	return style, nil
+}
+
+const remSize = 16
+
+func parseDimension(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		trimmed := strings.TrimSpace(v)
+		scale := 1.0
+		switch {
+		case strings.HasSuffix(trimmed, "rem"):
+			trimmed, scale = strings.TrimSuffix(trimmed, "rem"), remSize
+		case strings.HasSuffix(trimmed, "px"):
+			trimmed = strings.TrimSuffix(trimmed, "px")
+		}
+		number, err := strconv.ParseFloat(trimmed, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid dimension %q", v)
+		}
+		return number * scale, nil
+	case map[string]interface{}:
+		// The 2024 draft of the spec splits dimensions into {value, unit}.
+		unit, _ := v["unit"].(string)
+		return parseDimension(fmt.Sprint(v["value"]) + unit)
+	default:
+		return 0, fmt.Errorf("invalid dimension %v", value)
+	}
+}
+
+func isUnitless(value interface{}) bool {
+	switch v := value.(type) {
+	case float64, json.Number:
+		return true
+	case string:
+		_, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+var fontWeights = map[string]int{
+	"thin":        100,
+	"hairline":    100,
+	"extra-light": 200,
+	"ultra-light": 200,
+	"light":       300,
+	"normal":      400,
+	"regular":     400,
+	"book":        400,
+	"medium":      500,
+	"semi-bold":   600,
+	"demi-bold":   600,
+	"bold":        700,
+	"extra-bold":  800,
+	"ultra-bold":  800,
+	"black":       900,
+	"heavy":       900,
+}
+
+func parseFontWeight(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), nil
+	case json.Number:
+		weight, err := v.Int64()
+		return int(weight), err
+	case string:
+		if weight, ok := fontWeights[strings.ToLower(v)]; ok {
+			return weight, nil
+		}
+		if weight, err := strconv.Atoi(v); err == nil {
+			return weight, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid font weight %v", value)
+}
+
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
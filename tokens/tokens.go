@@ -0,0 +1,210 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type Token struct {
+	Path        string      `json:"path"`
+	Type        string      `json:"type"`
+	Value       interface{} `json:"value"`
+	Description string      `json:"description,omitempty"`
+}
+
+type Set struct {
+	tokens map[string]Token
+}
+
+var refPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+func Ref(path string) string {
+	return "{" + path + "}"
+}
+
+func IsRef(value string) bool {
+	match := refPattern.FindStringIndex(value)
+	return match != nil && match[0] == 0 && match[1] == len(value)
+}
+
+func NewSet() *Set {
+	return &Set{tokens: make(map[string]Token)}
+}
+
+// Parse reads a W3C design-token document. Style Dictionary files, which use
+// "value"/"type" instead of "$value"/"$type", are accepted as well.
+func Parse(data []byte) (*Set, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse design tokens: %w", err)
+	}
+	set := NewSet()
+	if err := set.collect(root, nil, ""); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+func LoadFile(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read design tokens: %w", err)
+	}
+	return Parse(data)
+}
+
+func (s *Set) collect(group map[string]interface{}, prefix []string, inheritedType string) error {
+	if t, ok := group["$type"].(string); ok {
+		inheritedType = t
+	}
+	for key, raw := range group {
+		if strings.HasPrefix(key, "$") {
+			continue
+		}
+		child, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := append(append([]string{}, prefix...), key)
+		value, isToken := child["$value"]
+		if !isToken {
+			value, isToken = child["value"]
+		}
+		if !isToken {
+			if err := s.collect(child, path, inheritedType); err != nil {
+				return err
+			}
+			continue
+		}
+
+		token := Token{Path: strings.Join(path, "."), Type: inheritedType, Value: value}
+		if t, ok := child["$type"].(string); ok {
+			token.Type = t
+		} else if t, ok := child["type"].(string); ok {
+			token.Type = t
+		}
+		if d, ok := child["$description"].(string); ok {
+			token.Description = d
+		} else if d, ok := child["comment"].(string); ok {
+			token.Description = d
+		}
+		s.tokens[token.Path] = token
+	}
+	return nil
+}
+
+// Merge copies every token from other into s, overriding tokens with the same path.
+func (s *Set) Merge(other *Set) {
+	for path, token := range other.tokens {
+		s.tokens[path] = token
+	}
+}
+
+func (s *Set) Add(token Token) {
+	s.tokens[token.Path] = token
+}
+
+func (s *Set) Lookup(path string) (Token, bool) {
+	token, ok := s.tokens[path]
+	return token, ok
+}
+
+func (s *Set) Paths() []string {
+	paths := make([]string, 0, len(s.tokens))
+	for path := range s.tokens {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// PathsUnder returns the paths of all tokens nested inside group, sorted.
+func (s *Set) PathsUnder(group string) []string {
+	var paths []string
+	for _, path := range s.Paths() {
+		if strings.HasPrefix(path, group+".") {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Resolve returns the value of the token at path with every alias, including
+// aliases nested inside composite values, replaced by its target value.
+func (s *Set) Resolve(path string) (interface{}, error) {
+	return s.resolvePath(path, map[string]bool{})
+}
+
+func (s *Set) resolvePath(path string, visiting map[string]bool) (interface{}, error) {
+	if visiting[path] {
+		return nil, fmt.Errorf("circular token reference at %s", path)
+	}
+	token, ok := s.tokens[path]
+	if !ok {
+		return nil, fmt.Errorf("unknown token %s", path)
+	}
+	visiting[path] = true
+	defer delete(visiting, path)
+	return s.resolveValue(token.Value, visiting)
+}
+
+func (s *Set) resolveValue(value interface{}, visiting map[string]bool) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return s.resolveString(v, visiting)
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			r, err := s.resolveValue(item, visiting)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = r
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			r, err := s.resolveValue(item, visiting)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	default:
+		return value, nil
+	}
+}
+
+// resolveString only resolves strings that are a reference as a whole, as
+// design-token aliases are. Braces inside longer strings, such as URL
+// templates like "/users/{id}" or regex quantifiers like `\d{3}`, are text.
+func (s *Set) resolveString(value string, visiting map[string]bool) (interface{}, error) {
+	if IsRef(value) {
+		return s.resolvePath(value[1:len(value)-1], visiting)
+	}
+	return value, nil
+}
+
+// ResolveJSON replaces every string value of a JSON document that is a token
+// reference, such as "{color.brand.primary}", with the referenced token's
+// value. An unknown whole-string reference is an error so typos are caught.
+func (s *Set) ResolveJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON for token resolution: %w", err)
+	}
+	resolved, err := s.resolveValue(document, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resolved)
+}
@@ -0,0 +1,134 @@
+package tokens
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"strings"
+	"testing"
+)
+
+const designTokens = `{
+  "color": {
+    "$type": "color",
+    "palette": {
+      "blue-500": { "$value": "#3B82F6" },
+      "gray-50": { "$value": "#F9FAFB" },
+      "gray-800": { "$value": "#1F2937" }
+    },
+    "brand": {
+      "primary": { "$value": "{color.palette.blue-500}" },
+      "secondary": { "$value": "#10B981", "$description": "Accent" }
+    },
+    "background": { "$value": "{color.palette.gray-50}" },
+    "text": { "$value": "{color.palette.gray-800}" }
+  },
+  "font": {
+    "body": { "$type": "fontFamily", "$value": ["Inter", "sans-serif"] }
+  },
+  "typography": {
+    "headline1": {
+      "$type": "typography",
+      "$value": {
+        "fontFamily": "{font.body}",
+        "fontSize": "2rem",
+        "fontWeight": "bold",
+        "lineHeight": 1.25,
+        "letterSpacing": "-0.5px"
+      }
+    }
+  },
+  "spacing": {
+    "$type": "dimension",
+    "sm": { "$value": "8px" },
+    "md": { "$value": "{spacing.sm}" }
+  },
+  "gradient": {
+    "hero": {
+      "$type": "gradient",
+      "$value": [
+        { "color": "{color.brand.primary}", "position": 0 },
+        { "color": "#000000", "position": 1 }
+      ]
+    }
+  }
+}`
+
+func TestThemeFromTokens(t *testing.T) {
+	set, err := Parse([]byte(designTokens))
+	if err != nil {
+		t.Fatalf("Failed to parse tokens: %v", err)
+	}
+
+	theme, err := set.Theme(DefaultThemeMapping())
+	if err != nil {
+		t.Fatalf("Failed to build theme: %v", err)
+	}
+
+	if theme.PrimaryColor != "#3B82F6" {
+		t.Errorf("Expected aliased primary color, got %q", theme.PrimaryColor)
+	}
+	if theme.TextColor != "#1F2937" {
+		t.Errorf("Unexpected text color %q", theme.TextColor)
+	}
+
+	headline, ok := theme.Typography[model.Headline1]
+	if !ok {
+		t.Fatal("Missing headline1 typography")
+	}
+	if headline.FontFamily != "Inter" || headline.FontSize != 32 || headline.FontWeight != 700 {
+		t.Errorf("Unexpected headline1 style: %+v", headline)
+	}
+	if headline.LineHeight == nil || *headline.LineHeight != 40 {
+		t.Errorf("Expected unitless line height to scale with font size, got %v", headline.LineHeight)
+	}
+
+	if theme.Spacing["md"] != (model.Dimension{Value: 8, Unit: model.Dp}) {
+		t.Errorf("Expected aliased spacing of 8dp, got %s", theme.Spacing["md"])
+	}
+
+	hero := theme.Gradients["hero"]
+	if len(hero.Colors) != 2 || hero.Colors[0] != "#3B82F6" {
+		t.Errorf("Unexpected hero gradient: %+v", hero)
+	}
+}
+
+func TestResolveJSON(t *testing.T) {
+	set, err := Parse([]byte(designTokens))
+	if err != nil {
+		t.Fatalf("Failed to parse tokens: %v", err)
+	}
+
+	resolved, err := set.ResolveJSON([]byte(`{"color":"{color.brand.primary}","text":"{{title}}","gap":"{spacing.md}"}`))
+	if err != nil {
+		t.Fatalf("Failed to resolve JSON: %v", err)
+	}
+	jsonStr := string(resolved)
+	if !strings.Contains(jsonStr, `"color":"#3B82F6"`) {
+		t.Errorf("Token reference was not resolved: %s", jsonStr)
+	}
+	if !strings.Contains(jsonStr, `"text":"{{title}}"`) {
+		t.Errorf("Item placeholder should be left alone: %s", jsonStr)
+	}
+
+	literal := `{"url":"/users/{id}","pattern":"^\\d{3}$","text":"Use {braces} freely"}`
+	resolved, err = set.ResolveJSON([]byte(literal))
+	if err != nil {
+		t.Fatalf("Braces inside longer strings should not be token references: %v", err)
+	}
+	if string(resolved) != `{"pattern":"^\\d{3}$","text":"Use {braces} freely","url":"/users/{id}"}` {
+		t.Errorf("Literal braces were changed: %s", resolved)
+	}
+
+	if _, err := set.ResolveJSON([]byte(`{"color":"{color.missing}"}`)); err == nil {
+		t.Error("Expected an error for an unknown token reference")
+	}
+}
+
+func TestCircularReference(t *testing.T) {
+	set, err := Parse([]byte(`{"a":{"$value":"{b}"},"b":{"$value":"{a}"}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse tokens: %v", err)
+	}
+	if _, err := set.Resolve("a"); err == nil || !strings.Contains(err.Error(), "circular") {
+		t.Errorf("Expected circular reference error, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package tree
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// instanceTemplate matches path segments whose nodes are rendered once per
+// item, section or placeholder rather than once per screen.
+var instanceTemplate = regexp.MustCompile(`\.(itemTemplate\.(layout|templates\.[^.]+)|dataSource\.items\[\d+\]\.template|loadingTemplate\.layout|sections\.headerTemplate)`)
+
+// Scope returns the path of the innermost template rendered once per item,
+// section or loading placeholder that contains path, or "" for nodes
+// rendered once per screen. IDs only need to be unique within a scope.
+func Scope(path string) string {
+	matches := instanceTemplate.FindAllStringIndex(path, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	return path[:matches[len(matches)-1][1]]
+}
+
+// AssignScreenIDs gives every layout node without an ID a generated one,
+// prefixed with the screen ID.
+func AssignScreenIDs(screen *model.ComponentScreen) {
+	AssignIDs(&screen.Screen.Layout, screen.Screen.ID)
+}
+
+// AssignIDs gives every node under root that has no ID a deterministic one
+// built from its place in the tree, e.g. "home-column_0-card_1-text_0". It
+// joins the parent's ID (or prefix for root), the template slot the node sits
+// in, the node type and the node's key: its "key" or "name" property when
+// set, otherwise its index among siblings of the same type. Editing a node's
+// properties or inserting siblings of another type therefore renames nothing;
+// inserting or reordering siblings of the same type renames the ones after
+// it, so give those keys or explicit IDs where that matters.
+func AssignIDs(root *model.ComponentNode, prefix string) {
+	used := make(map[string]map[string]bool)
+	Walk(root, "", func(node, _ *model.ComponentNode, path string) error {
+		if node.ID != nil {
+			scopeIDs(used, Scope(path))[*node.ID] = true
+		}
+		return nil
+	})
+
+	paths := make(map[*model.ComponentNode]string)
+	Walk(root, "", func(node, parent *model.ComponentNode, path string) error {
+		paths[node] = path
+		if node.ID != nil {
+			return nil
+		}
+		base := prefix
+		if parent != nil {
+			base = joinID(*parent.ID, slotName(strings.TrimPrefix(path, paths[parent]+".")))
+		}
+
+		id := joinID(base, sanitize(node.Type)+"_"+nodeKey(node, parent))
+		ids := scopeIDs(used, Scope(path))
+		candidate := id
+		for n := 2; ids[candidate]; n++ {
+			candidate = fmt.Sprintf("%s-%d", id, n)
+		}
+		ids[candidate] = true
+		node.ID = &candidate
+		return nil
+	})
+}
+
+func scopeIDs(used map[string]map[string]bool, scope string) map[string]bool {
+	if used[scope] == nil {
+		used[scope] = make(map[string]bool)
+	}
+	return used[scope]
+}
+
+func joinID(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, "-")
+}
+
+// slotName names the template slot a node sits in, or "" for children.
+func slotName(slot string) string {
+	switch {
+	case strings.HasPrefix(slot, "children["):
+		return ""
+	case slot == "itemTemplate.layout" || strings.HasPrefix(slot, "dataSource.items["):
+		return "item"
+	case strings.HasPrefix(slot, "itemTemplate.templates."):
+		return "item_" + sanitize(strings.TrimPrefix(slot, "itemTemplate.templates."))
+	case slot == "loadingTemplate.layout":
+		return "loading"
+	case slot == "sections.headerTemplate":
+		return "header"
+	case slot == "emptyTemplate":
+		return "empty"
+	case slot == "errorTemplate":
+		return "error"
+	default:
+		return sanitize(slot)
+	}
+}
+
+// nodeKey is the node's "key" or "name" property, or its index among the
+// parent's children of the same type. Template slots hold a single node.
+func nodeKey(node, parent *model.ComponentNode) string {
+	for _, property := range []string{"key", "name"} {
+		if key, ok := node.Properties[property].(string); ok && key != "" {
+			return sanitize(key)
+		}
+	}
+
+	if parent != nil {
+		index := 0
+		for i := range parent.Children {
+			if &parent.Children[i] == node {
+				return strconv.Itoa(index)
+			}
+			if parent.Children[i].Type == node.Type {
+				index++
+			}
+		}
+	}
+	return "0"
+}
+
+// sanitize keeps IDs usable in "#id" selectors.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,117 @@
+package tree_test
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"testing"
+)
+
+func idsByPath(t *testing.T, screen *model.ComponentScreen) map[string]string {
+	t.Helper()
+	ids := make(map[string]string)
+	tree.WalkScreen(screen, func(node, _ *model.ComponentNode, path string) error {
+		if node.ID == nil {
+			t.Errorf("Node at %s has no ID", path)
+			return nil
+		}
+		ids[path] = *node.ID
+		return nil
+	})
+	return ids
+}
+
+func homeScreen(children ...model.ComponentNode) model.ComponentScreen {
+	return ui.NewScreen("home", "Home", "1.0").WithLayout(ui.Column(children...)).Build()
+}
+
+func TestAssignIDs(t *testing.T) {
+	screen := homeScreen(
+		ui.Text("Title"),
+		ui.NewComponent("button").WithID("save").WithChildren(ui.Text("Save")).Build(),
+		ui.TextField("email", "Email").Build(),
+		ui.LazyColumn(ui.StaticDataSource(nil), model.ItemTemplate{Type: "post", Layout: ui.Row(ui.Text("{{title}}"))}),
+		ui.Card(ui.Text("Body")),
+		ui.Text("Footer"),
+	)
+	tree.AssignScreenIDs(&screen)
+	ids := idsByPath(t, &screen)
+
+	for path, want := range map[string]string{
+		"screen.layout":                                             "home-column_0",
+		"screen.layout.children[0]":                                 "home-column_0-text_0",
+		"screen.layout.children[1]":                                 "save",
+		"screen.layout.children[1].children[0]":                     "save-text_0",
+		"screen.layout.children[2]":                                 "home-column_0-text_field_email",
+		"screen.layout.children[3].itemTemplate.layout":             "home-column_0-lazy_column_0-item-row_0",
+		"screen.layout.children[3].itemTemplate.layout.children[0]": "home-column_0-lazy_column_0-item-row_0-text_0",
+		"screen.layout.children[4].children[0]":                     "home-column_0-card_0-text_0",
+		"screen.layout.children[5]":                                 "home-column_0-text_1",
+	} {
+		if ids[path] != want {
+			t.Errorf("ID at %s = %q, want %q", path, ids[path], want)
+		}
+	}
+}
+
+func TestAssignIDsIsStable(t *testing.T) {
+	body := func(count string) []model.ComponentNode {
+		return []model.ComponentNode{ui.Text(count), ui.Card(ui.Text("Body")), ui.Text("Footer")}
+	}
+	before := homeScreen(body("Count: 1")...)
+	tree.AssignScreenIDs(&before)
+
+	edits := map[string]model.ComponentScreen{
+		"text edited":                 homeScreen(body("Count: 2")...),
+		"other-type sibling inserted": homeScreen(append([]model.ComponentNode{ui.Image("banner.png")}, body("Count: 1")...)...),
+	}
+	for name, after := range edits {
+		tree.AssignScreenIDs(&after)
+		afterIDs := make(map[string]bool)
+		tree.WalkScreen(&after, func(node, _ *model.ComponentNode, _ string) error {
+			afterIDs[*node.ID] = true
+			return nil
+		})
+		tree.WalkScreen(&before, func(node, _ *model.ComponentNode, path string) error {
+			if !afterIDs[*node.ID] {
+				t.Errorf("%s: %s lost its ID %q", name, path, *node.ID)
+			}
+			return nil
+		})
+	}
+
+	keyed := func(children ...model.ComponentNode) model.ComponentScreen {
+		screen := homeScreen(children...)
+		tree.AssignScreenIDs(&screen)
+		return screen
+	}
+	intro := ui.NewComponent("text").WithProperty("key", "intro").Build()
+	outro := ui.NewComponent("text").WithProperty("key", "outro").Build()
+	first := keyed(intro, outro)
+	second := keyed(outro, ui.Text("new"), intro)
+	if *first.Screen.Layout.Children[0].ID != *second.Screen.Layout.Children[2].ID {
+		t.Error("Keyed nodes should keep their IDs when same-type siblings move")
+	}
+
+	again := homeScreen(body("Count: 1")...)
+	tree.AssignScreenIDs(&again)
+	tree.AssignScreenIDs(&again)
+	if *again.Screen.Layout.Children[1].ID != *before.Screen.Layout.Children[1].ID {
+		t.Error("Assigning twice should keep the first IDs")
+	}
+}
+
+func TestAssignIDsAvoidsCollisions(t *testing.T) {
+	root := ui.Column(ui.NewComponent("text").WithProperty("key", "same").Build(), ui.NewComponent("text").WithProperty("key", "same").Build())
+	tree.AssignIDs(&root, "")
+	first, second := *root.Children[0].ID, *root.Children[1].ID
+	if second != first+"-2" {
+		t.Errorf("Expected siblings with the same key to be numbered, got %q and %q", first, second)
+	}
+
+	explicit := ui.Column(ui.NewComponent("text").WithID("column_0-text_1").Build(), ui.Text("Same"))
+	tree.AssignIDs(&explicit, "")
+	if got := *explicit.Children[1].ID; got != "column_0-text_1-2" {
+		t.Errorf("Expected a generated ID colliding with an explicit one to get a suffix, got %q", got)
+	}
+}
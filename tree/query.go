@@ -0,0 +1,325 @@
+package tree
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"strings"
+)
+
+// Match is a node found by a query. Node points into the queried tree
+// except for per-type item templates and templates embedded in items, which
+// are held in maps and matched as copies.
+type Match struct {
+	Node *model.ComponentNode
+	Path string
+}
+
+// Selector is a compiled CSS-like selector. Supported syntax:
+//
+//	text             nodes of type text
+//	*                any node
+//	#submit_button   the node with that ID
+//	[style=headline2] property equals value; also [key], [key!=v],
+//	                 [key^=prefix], [key$=suffix] and [key*=substring]
+//	card > text      text nodes whose parent is a card
+//	card text        text nodes nested anywhere under a card
+//	button, image    either selector
+//
+// Parents include the list a template belongs to, so "lazy_column > text"
+// matches a text item template layout.
+type Selector struct {
+	source string
+	groups [][]compound
+}
+
+type combinator int
+
+const (
+	descendant combinator = iota
+	child
+)
+
+type compound struct {
+	nodeType   string
+	id         string
+	attributes []attribute
+	combinator combinator // relation to the previous compound
+}
+
+type attribute struct {
+	name  string
+	op    string // "", "=", "!=", "^=", "$=", "*="
+	value string
+}
+
+// Compile parses a selector such as `lazy_column > text[text^="Hi"], #title`
+// so it can be queried repeatedly. Compound selectors combine a type or "*",
+// an "#id" and "[name op value]" property tests, joined by descendant or ">"
+// combinators; comma-separated groups match if any group does.
+func Compile(selector string) (*Selector, error) {
+	s := &Selector{source: selector}
+	for _, group := range splitGroups(selector) {
+		compounds, err := parseGroup(group)
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", selector, err)
+		}
+		s.groups = append(s.groups, compounds)
+	}
+	return s, nil
+}
+
+// MustCompile is like Compile but panics if the selector is invalid. It is
+// meant for selectors known at compile time.
+func MustCompile(selector string) *Selector {
+	s, err := Compile(selector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func (s *Selector) String() string {
+	return s.source
+}
+
+// Query returns the nodes under root, root included, that match selector in
+// walk order.
+func Query(root *model.ComponentNode, path, selector string) ([]Match, error) {
+	s, err := Compile(selector)
+	if err != nil {
+		return nil, err
+	}
+	return s.Query(root, path), nil
+}
+
+// QueryScreen queries the screen layout with paths rooted at "screen.layout".
+func QueryScreen(screen *model.ComponentScreen, selector string) ([]Match, error) {
+	return Query(&screen.Screen.Layout, "screen.layout", selector)
+}
+
+// Query returns the nodes under root, root included, that match the selector
+// in walk order. Paths are rooted at path.
+func (s *Selector) Query(root *model.ComponentNode, path string) []Match {
+	var matches []Match
+	parents := make(map[*model.ComponentNode]*model.ComponentNode)
+	Walk(root, path, func(node, parent *model.ComponentNode, path string) error {
+		parents[node] = parent
+		for _, group := range s.groups {
+			if matchFrom(node, group, len(group)-1, parents) {
+				matches = append(matches, Match{Node: node, Path: path})
+				break
+			}
+		}
+		return nil
+	})
+	return matches
+}
+
+func matchFrom(node *model.ComponentNode, compounds []compound, index int, parents map[*model.ComponentNode]*model.ComponentNode) bool {
+	current := compounds[index]
+	if !current.matches(node) {
+		return false
+	}
+	if index == 0 {
+		return true
+	}
+	for ancestor := parents[node]; ancestor != nil; ancestor = parents[ancestor] {
+		if matchFrom(ancestor, compounds, index-1, parents) {
+			return true
+		}
+		if current.combinator == child {
+			return false
+		}
+	}
+	return false
+}
+
+func (c compound) matches(node *model.ComponentNode) bool {
+	if c.nodeType != "" && c.nodeType != "*" && c.nodeType != node.Type {
+		return false
+	}
+	if c.id != "" && (node.ID == nil || *node.ID != c.id) {
+		return false
+	}
+	for _, attr := range c.attributes {
+		raw, ok := node.Properties[attr.name]
+		if !ok || raw == nil {
+			if attr.op == "!=" {
+				continue
+			}
+			return false
+		}
+		value := fmt.Sprint(raw)
+		switch attr.op {
+		case "=":
+			ok = value == attr.value
+		case "!=":
+			ok = value != attr.value
+		case "^=":
+			ok = strings.HasPrefix(value, attr.value)
+		case "$=":
+			ok = strings.HasSuffix(value, attr.value)
+		case "*=":
+			ok = strings.Contains(value, attr.value)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// splitGroups splits on commas outside attribute brackets and quotes.
+func splitGroups(selector string) []string {
+	var groups []string
+	depth, start := 0, 0
+	var quote byte
+	for i := 0; i < len(selector); i++ {
+		c := selector[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			groups = append(groups, selector[start:i])
+			start = i + 1
+		}
+	}
+	return append(groups, selector[start:])
+}
+
+func parseGroup(group string) ([]compound, error) {
+	var compounds []compound
+	next := descendant
+	i := 0
+	for {
+		for i < len(group) && isSpace(group[i]) {
+			i++
+		}
+		if i == len(group) {
+			break
+		}
+		if group[i] == '>' {
+			if len(compounds) == 0 || next == child {
+				return nil, fmt.Errorf("unexpected '>' at offset %d", i)
+			}
+			next = child
+			i++
+			continue
+		}
+		c, end, err := parseCompound(group, i)
+		if err != nil {
+			return nil, err
+		}
+		c.combinator = next
+		compounds = append(compounds, c)
+		next = descendant
+		i = end
+	}
+	if len(compounds) == 0 {
+		return nil, fmt.Errorf("empty selector")
+	}
+	if next == child {
+		return nil, fmt.Errorf("selector ends with '>'")
+	}
+	return compounds, nil
+}
+
+func parseCompound(s string, i int) (compound, int, error) {
+	var c compound
+	start := i
+	if i < len(s) && s[i] == '*' {
+		c.nodeType = "*"
+		i++
+	} else {
+		c.nodeType, i = readName(s, i)
+	}
+	for i < len(s) && !isSpace(s[i]) && s[i] != '>' {
+		switch s[i] {
+		case '#':
+			c.id, i = readName(s, i+1)
+			if c.id == "" {
+				return c, i, fmt.Errorf("empty ID at offset %d", i)
+			}
+		case '[':
+			end := closingBracket(s[i:])
+			if end < 0 {
+				return c, i, fmt.Errorf("unclosed '[' at offset %d", i)
+			}
+			attr, err := parseAttribute(s[i+1 : i+end])
+			if err != nil {
+				return c, i, err
+			}
+			c.attributes = append(c.attributes, attr)
+			i += end + 1
+		default:
+			return c, i, fmt.Errorf("unexpected %q at offset %d", s[i], i)
+		}
+	}
+	if i == start {
+		return c, i, fmt.Errorf("expected a selector at offset %d", i)
+	}
+	return c, i, nil
+}
+
+// closingBracket returns the offset of the ']' closing the attribute selector
+// that s starts with, skipping brackets inside quoted values, or -1.
+func closingBracket(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseAttribute(body string) (attribute, error) {
+	body = strings.TrimSpace(body)
+	if index := strings.IndexByte(body, '='); index >= 0 {
+		op, nameEnd := "=", index
+		if index > 0 && strings.IndexByte("!^$*", body[index-1]) >= 0 {
+			op, nameEnd = body[index-1:index+1], index-1
+		}
+		name := strings.TrimSpace(body[:nameEnd])
+		value := strings.TrimSpace(body[index+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		if name == "" {
+			return attribute{}, fmt.Errorf("attribute [%s] has no name", body)
+		}
+		return attribute{name: name, op: op, value: value}, nil
+	}
+	if body == "" {
+		return attribute{}, fmt.Errorf("empty attribute selector")
+	}
+	return attribute{name: body}, nil
+}
+
+func readName(s string, i int) (string, int) {
+	start := i
+	for i < len(s) && (s[i] == '_' || s[i] == '-' || s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z' || s[i] >= '0' && s[i] <= '9') {
+		i++
+	}
+	return s[start:i], i
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
@@ -0,0 +1,78 @@
+package tree_test
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"reflect"
+	"testing"
+)
+
+func profileScreen() model.ComponentScreen {
+	return ui.NewScreen("profile", "Profile", "1.0").
+		WithLayout(ui.Column(
+			ui.StyledText("Ada Lovelace", model.Headline2),
+			ui.Card(
+				ui.StyledText("About", model.Headline2),
+				ui.Text("Mathematician"),
+				ui.Row(ui.Text("London")),
+			),
+			ui.NewComponent("button").WithID("submit_button").WithProperty("text", "Save").Build(),
+			ui.LazyColumn(ui.StaticDataSource(nil), model.ItemTemplate{Type: "post", Layout: ui.Text("{{title}}")}),
+		)).
+		Build()
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{"card > text[style=headline2]", []string{"screen.layout.children[1].children[0]"}},
+		{"#submit_button", []string{"screen.layout.children[2]"}},
+		{"card text", []string{"screen.layout.children[1].children[0]", "screen.layout.children[1].children[1]", "screen.layout.children[1].children[2].children[0]"}},
+		{"card > text", []string{"screen.layout.children[1].children[0]", "screen.layout.children[1].children[1]"}},
+		{"text[text^=Math]", []string{"screen.layout.children[1].children[1]"}},
+		{"text[style!=headline2][text*=t]", []string{"screen.layout.children[1].children[1]", "screen.layout.children[3].itemTemplate.layout"}},
+		{"lazy_column > text", []string{"screen.layout.children[3].itemTemplate.layout"}},
+		{"button[text='Save'], row", []string{"screen.layout.children[1].children[2]", "screen.layout.children[2]"}},
+		{"column > * > row", []string{"screen.layout.children[1].children[2]"}},
+		{"image", nil},
+	}
+
+	screen := profileScreen()
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			matches, err := tree.QueryScreen(&screen, tt.selector)
+			if err != nil {
+				t.Fatalf("Query returned error: %v", err)
+			}
+			var paths []string
+			for _, match := range matches {
+				paths = append(paths, match.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.want) {
+				t.Errorf("Query(%q) = %v, want %v", tt.selector, paths, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryQuotedBrackets(t *testing.T) {
+	root := ui.Column(ui.Text("a]b"), ui.Text("a"))
+	matches, err := tree.Query(&root, "root", `text[text="a]b"], text[text='[a']`)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Path != "root.children[0]" {
+		t.Errorf("Expected only root.children[0] to match, got %+v", matches)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, selector := range []string{"", "card >", "> text", "card > > text", "text[style", "#", "text[=x]", "text!", `text[text="a]`} {
+		if _, err := tree.Compile(selector); err == nil {
+			t.Errorf("Expected Compile(%q) to fail", selector)
+		}
+	}
+}
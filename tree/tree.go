@@ -0,0 +1,170 @@
+package tree
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"reflect"
+)
+
+var (
+	// SkipChildren returned from a WalkFunc skips the nodes nested in the
+	// current node, including its templates.
+	SkipChildren = errors.New("skip children")
+	// Stop returned from a WalkFunc ends the walk; Walk then returns nil.
+	Stop = errors.New("stop walk")
+)
+
+// WalkFunc is called for each node before the nodes nested in it. node
+// points into the tree, so assigning to *node rewrites it in place and the
+// walk continues into the rewritten node's children. parent is nil for the
+// root.
+type WalkFunc func(node, parent *model.ComponentNode, path string) error
+
+// WalkScreen walks the screen layout with paths rooted at "screen.layout".
+func WalkScreen(screen *model.ComponentScreen, fn WalkFunc) error {
+	return Walk(&screen.Screen.Layout, "screen.layout", fn)
+}
+
+// Walk visits root and every node nested in it: children, item templates
+// and per-type templates, loading skeletons, section headers, empty and
+// error templates, and templates embedded in data source items. Nested
+// nodes are visited in that order and per-type templates by name. Embedded
+// templates are walked whether they are held as nodes or, after a screen has
+// been decoded from JSON, as generic maps.
+func Walk(root *model.ComponentNode, path string, fn WalkFunc) error {
+	err := walk(root, nil, path, fn)
+	if errors.Is(err, Stop) {
+		return nil
+	}
+	return err
+}
+
+// Templates held in maps are walked as copies and stored back only when the
+// WalkFunc changed them, so read-only walks never write to shared maps. Nodes
+// nested in a typed copy share their storage with the original, so only the
+// copy's own fields need to be compared.
+func walk(node, parent *model.ComponentNode, path string, fn WalkFunc) error {
+	if err := fn(node, parent, path); err != nil {
+		if errors.Is(err, SkipChildren) {
+			return nil
+		}
+		return err
+	}
+
+	for i := range node.Children {
+		if err := walk(&node.Children[i], node, fmt.Sprintf("%s.children[%d]", path, i), fn); err != nil {
+			return err
+		}
+	}
+	if node.ItemTemplate != nil {
+		if node.ItemTemplate.Layout.Type != "" {
+			if err := walk(&node.ItemTemplate.Layout, node, path+".itemTemplate.layout", fn); err != nil {
+				return err
+			}
+		}
+		for _, name := range utils.SortedKeys(node.ItemTemplate.Templates) {
+			template := node.ItemTemplate.Templates[name]
+			err := walk(&template, node, path+".itemTemplate.templates."+name, fn)
+			if changed(node.ItemTemplate.Templates[name], template) {
+				node.ItemTemplate.Templates[name] = template
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if node.LoadingTemplate != nil && node.LoadingTemplate.Layout != nil {
+		if err := walk(node.LoadingTemplate.Layout, node, path+".loadingTemplate.layout", fn); err != nil {
+			return err
+		}
+	}
+	if node.Sections != nil {
+		if err := walk(&node.Sections.HeaderTemplate, node, path+".sections.headerTemplate", fn); err != nil {
+			return err
+		}
+	}
+	if node.EmptyTemplate != nil {
+		if err := walk(node.EmptyTemplate, node, path+".emptyTemplate", fn); err != nil {
+			return err
+		}
+	}
+	if node.ErrorTemplate != nil {
+		if err := walk(node.ErrorTemplate, node, path+".errorTemplate", fn); err != nil {
+			return err
+		}
+	}
+	if node.DataSource != nil {
+		for i, item := range node.DataSource.Items {
+			templatePath := fmt.Sprintf("%s.dataSource.items[%d].template", path, i)
+			var err error
+			switch template := item["template"].(type) {
+			case model.ComponentNode:
+				walked := template
+				err = walk(&walked, node, templatePath, fn)
+				if changed(template, walked) {
+					item["template"] = walked
+				}
+			case map[string]interface{}:
+				err = walkDecoded(item, template, node, templatePath, fn)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkDecoded walks a template that was decoded from JSON into a generic map,
+// as data source items are when a screen is unmarshalled. The map is converted
+// to a node for the walk and replaced only when the WalkFunc changed the node.
+func walkDecoded(item map[string]interface{}, template map[string]interface{}, parent *model.ComponentNode, path string, fn WalkFunc) error {
+	if _, ok := template["type"].(string); !ok {
+		return nil
+	}
+	before, err := json.Marshal(template)
+	if err != nil {
+		return nil
+	}
+	var node model.ComponentNode
+	if err := json.Unmarshal(before, &node); err != nil {
+		return nil
+	}
+	before, _ = json.Marshal(node)
+	walkErr := walk(&node, parent, path, fn)
+	if after, err := json.Marshal(node); err == nil && !bytes.Equal(before, after) {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(after, &decoded); err == nil {
+			item["template"] = decoded
+		}
+	}
+	return walkErr
+}
+
+// changed reports whether the WalkFunc replaced any field of a template copy.
+// Fields are compared by identity, not by content.
+func changed(before, after model.ComponentNode) bool {
+	a, b := reflect.ValueOf(before), reflect.ValueOf(after)
+	for i := 0; i < a.NumField(); i++ {
+		x, y := a.Field(i), b.Field(i)
+		switch x.Kind() {
+		case reflect.Ptr, reflect.Map:
+			if x.Pointer() != y.Pointer() {
+				return true
+			}
+		case reflect.Slice:
+			if x.Pointer() != y.Pointer() || x.Len() != y.Len() {
+				return true
+			}
+		default:
+			if x.Interface() != y.Interface() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package tree_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func feedScreen() model.ComponentScreen {
+	list := ui.LazyColumnWithStates(
+		ui.APIDataSource("/api/feed", "GET"),
+		ui.MultiItemTemplate("post", ui.Text("{{title}}"), map[string]model.ComponentNode{
+			"video": ui.Column(ui.Text("{{caption}}")),
+		}),
+		ui.ListStates{Empty: ui.NodePtr(ui.EmptyState("Nothing yet")), Error: ui.NodePtr(ui.ErrorState("Failed", "Retry"))},
+	)
+	inline := ui.NewComponent("lazy_column").
+		WithDataSource(ui.EnhancedStaticDataSource([]map[string]interface{}{
+			ui.ItemWithTemplate(map[string]interface{}{"title": "Hi"}, ui.Text("{{title}}")),
+		})).
+		Build()
+	sectioned := ui.SectionedLazyColumn(
+		ui.StaticDataSource(nil),
+		ui.Sections("day", ui.Text(ui.SectionKeyPlaceholder)),
+		model.ItemTemplate{Type: "event", Layout: ui.Text("{{event}}")},
+	)
+	return ui.NewScreen("feed", "Feed", "1.0").WithLayout(ui.Column(list, inline, sectioned)).Build()
+}
+
+func TestWalkVisitsEveryLocation(t *testing.T) {
+	screen := feedScreen()
+	var paths []string
+	err := tree.WalkScreen(&screen, func(node, parent *model.ComponentNode, path string) error {
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkScreen returned error: %v", err)
+	}
+
+	joined := strings.Join(paths, "\n")
+	for _, want := range []string{
+		"screen.layout.children[0].itemTemplate.layout",
+		"screen.layout.children[0].itemTemplate.templates.video.children[0]",
+		"screen.layout.children[0].loadingTemplate.layout",
+		"screen.layout.children[0].emptyTemplate.children[0]",
+		"screen.layout.children[0].errorTemplate.children[2]",
+		"screen.layout.children[1].dataSource.items[0].template",
+		"screen.layout.children[2].sections.headerTemplate",
+	} {
+		if !strings.Contains(joined, want+"\n") && !strings.HasSuffix(joined, want) {
+			t.Errorf("Walk did not visit %s; visited:\n%s", want, joined)
+		}
+	}
+}
+
+func TestWalkSkipAndStop(t *testing.T) {
+	screen := feedScreen()
+	var visited []string
+	tree.WalkScreen(&screen, func(node, parent *model.ComponentNode, path string) error {
+		visited = append(visited, path)
+		if path == "screen.layout.children[0]" {
+			return tree.SkipChildren
+		}
+		if path == "screen.layout.children[1].dataSource.items[0].template" {
+			return tree.Stop
+		}
+		return nil
+	})
+	want := []string{
+		"screen.layout",
+		"screen.layout.children[0]",
+		"screen.layout.children[1]",
+		"screen.layout.children[1].dataSource.items[0].template",
+	}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("Visited %v, want %v", visited, want)
+	}
+
+	failure := errors.New("boom")
+	if err := tree.WalkScreen(&screen, func(*model.ComponentNode, *model.ComponentNode, string) error { return failure }); err != failure {
+		t.Errorf("Expected WalkFunc error to be returned, got %v", err)
+	}
+}
+
+func TestWalkRewritesInPlace(t *testing.T) {
+	screen := feedScreen()
+	tree.WalkScreen(&screen, func(node, parent *model.ComponentNode, path string) error {
+		if node.Type == "text" {
+			*node = ui.NewComponent("text").WithID(path).WithProperty("text", "rewritten").Build()
+		}
+		return nil
+	})
+
+	list := screen.Screen.Layout.Children[0]
+	if list.ItemTemplate.Layout.Properties["text"] != "rewritten" {
+		t.Errorf("Item template layout was not rewritten: %+v", list.ItemTemplate.Layout)
+	}
+	if list.ItemTemplate.Templates["video"].Children[0].Properties["text"] != "rewritten" {
+		t.Errorf("Per-type template was not rewritten: %+v", list.ItemTemplate.Templates["video"])
+	}
+	inline := screen.Screen.Layout.Children[1].DataSource.Items[0]["template"].(model.ComponentNode)
+	if inline.Properties["text"] != "rewritten" {
+		t.Errorf("Embedded item template was not rewritten: %+v", inline)
+	}
+}
+
+func TestWalkDecodedItemTemplates(t *testing.T) {
+	screen := feedScreen()
+	data, err := json.Marshal(screen)
+	if err != nil {
+		t.Fatalf("Failed to marshal screen: %v", err)
+	}
+	var decoded model.ComponentScreen
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal screen: %v", err)
+	}
+
+	item := decoded.Screen.Layout.Children[1].DataSource.Items[0]
+	original := item["template"]
+	var visited bool
+	tree.WalkScreen(&decoded, func(node, parent *model.ComponentNode, path string) error {
+		visited = visited || path == "screen.layout.children[1].dataSource.items[0].template"
+		return nil
+	})
+	if !visited {
+		t.Fatal("Walk did not visit the decoded embedded template")
+	}
+	if !reflect.DeepEqual(item["template"], original) {
+		t.Errorf("Read-only walk replaced the decoded template: %+v", item["template"])
+	}
+
+	tree.WalkScreen(&decoded, func(node, parent *model.ComponentNode, path string) error {
+		if strings.HasSuffix(path, ".template") {
+			node.Properties = map[string]interface{}{"text": "rewritten"}
+		}
+		return nil
+	})
+	template := item["template"].(map[string]interface{})
+	if template["properties"].(map[string]interface{})["text"] != "rewritten" {
+		t.Errorf("Decoded template was not rewritten: %+v", template)
+	}
+}
@@ -0,0 +1,193 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+type ActionBuilder struct {
+	action model.ActionConfig
+}
+
+func NewAction(actionType string) *ActionBuilder {
+	return &ActionBuilder{action: model.ActionConfig{Type: actionType}}
+}
+
+// SubmitForm collects the named fields and POSTs them to endpoint as JSON.
+func SubmitForm(endpoint string, fields ...string) *ActionBuilder {
+	return NewAction("submit_form").
+		Endpoint(endpoint).
+		Method("POST").
+		Fields(fields...)
+}
+
+// APICall calls a literal endpoint URL.
+func APICall(method, endpoint string) *ActionBuilder {
+	return NewAction("api_call").
+		Method(method).
+		Endpoint(endpoint)
+}
+
+// APICallTo calls the endpoint registered under key in DataConfig.APIEndpoints.
+func APICallTo(method, key string) *ActionBuilder {
+	return NewAction("api_call").
+		Method(method).
+		EndpointKey(key)
+}
+
+// Dispatch calls the handler registered under actionID on the action
+// registry served at endpoint.
+func Dispatch(endpoint, actionID string) *ActionBuilder {
+	return APICall("POST", endpoint).ActionID(actionID)
+}
+
+func (ab *ActionBuilder) ActionID(actionID string) *ActionBuilder {
+	ab.action.ActionID = &actionID
+	return ab
+}
+
+func (ab *ActionBuilder) EndpointKey(key string) *ActionBuilder {
+	ab.action.EndpointKey = &key
+	ab.action.Endpoint = nil
+	return ab
+}
+
+func (ab *ActionBuilder) Endpoint(endpoint string) *ActionBuilder {
+	ab.action.Endpoint = &endpoint
+	ab.action.EndpointKey = nil
+	return ab
+}
+
+func (ab *ActionBuilder) Method(method string) *ActionBuilder {
+	ab.action.Method = &method
+	return ab
+}
+
+func (ab *ActionBuilder) Fields(fields ...string) *ActionBuilder {
+	ab.action.Fields = append(ab.action.Fields, fields...)
+	return ab
+}
+
+func (ab *ActionBuilder) Param(key, value string) *ActionBuilder {
+	if ab.action.Params == nil {
+		ab.action.Params = make(map[string]string)
+	}
+	ab.action.Params[key] = value
+	return ab
+}
+
+func (ab *ActionBuilder) Header(key, value string) *ActionBuilder {
+	if ab.action.Headers == nil {
+		ab.action.Headers = make(map[string]string)
+	}
+	ab.action.Headers[key] = value
+	return ab
+}
+
+// Body sets the request body template. String values may contain
+// {{placeholders}} that the client binds to the acting item's data.
+func (ab *ActionBuilder) Body(body map[string]interface{}) *ActionBuilder {
+	ab.action.Body = body
+	return ab
+}
+
+func (ab *ActionBuilder) BodyField(key string, value interface{}) *ActionBuilder {
+	if ab.action.Body == nil {
+		ab.action.Body = make(map[string]interface{})
+	}
+	ab.action.Body[key] = value
+	return ab
+}
+
+func (ab *ActionBuilder) Optimistic(properties map[string]interface{}) *ActionBuilder {
+	ab.action.Optimistic = &model.OptimisticUpdate{Properties: properties}
+	return ab
+}
+
+func (ab *ActionBuilder) OnSuccess(action model.ActionConfig) *ActionBuilder {
+	ab.action.OnSuccess = &action
+	return ab
+}
+
+func (ab *ActionBuilder) OnError(action model.ActionConfig) *ActionBuilder {
+	ab.action.OnError = &action
+	return ab
+}
+
+func (ab *ActionBuilder) Build() model.ActionConfig {
+	return ab.action
+}
+
+func ShowMessageAction(message string) model.ActionConfig {
+	return model.ActionConfig{
+		Type:    "show_message",
+		Message: &message,
+	}
+}
+
+// ReplaceScreenAction swaps the current screen for the one served at url
+// without pushing a new entry onto the back stack.
+func ReplaceScreenAction(url string) model.ActionConfig {
+	return model.ActionConfig{
+		Type:        "replace_screen",
+		Destination: &url,
+	}
+}
+
+// AnalyticsAction logs event with params and does nothing visible, so it is
+// usually the first step of a Sequence.
+func AnalyticsAction(event string, params map[string]string) model.ActionConfig {
+	return model.ActionConfig{
+		Type:   "analytics",
+		Event:  &event,
+		Params: params,
+	}
+}
+
+// Sequence runs actions in order and stops at the first one that fails.
+func Sequence(actions ...model.ActionConfig) model.ActionConfig {
+	return model.ActionConfig{
+		Type:    "sequence",
+		Actions: actions,
+	}
+}
+
+func Parallel(actions ...model.ActionConfig) model.ActionConfig {
+	return model.ActionConfig{
+		Type:    "parallel",
+		Actions: actions,
+	}
+}
+
+// If runs then when condition holds, e.g. "state.loggedIn && item.stock > 0".
+// Chain Else for the other branch.
+func If(condition string, then model.ActionConfig) *ActionBuilder {
+	ab := NewAction("conditional")
+	ab.action.Condition = &condition
+	ab.action.Then = &then
+	return ab
+}
+
+func (ab *ActionBuilder) Else(action model.ActionConfig) *ActionBuilder {
+	ab.action.Else = &action
+	return ab
+}
+
+// Confirm asks the user to confirm with a dialog before running then.
+func Confirm(title, message string, then model.ActionConfig) *ActionBuilder {
+	ab := NewAction("confirm")
+	ab.action.Confirm = &model.ConfirmConfig{
+		Title:       &title,
+		Message:     message,
+		ConfirmText: "OK",
+		CancelText:  "Cancel",
+	}
+	ab.action.Then = &then
+	return ab
+}
+
+func (ab *ActionBuilder) ConfirmLabels(confirmText, cancelText string) *ActionBuilder {
+	if ab.action.Confirm == nil {
+		ab.action.Confirm = &model.ConfirmConfig{}
+	}
+	ab.action.Confirm.ConfirmText = confirmText
+	ab.action.Confirm.CancelText = cancelText
+	return ab
+}
@@ -28,8 +28,36 @@ func (cb *ComponentBuilder) WithProperty(key string, value interface{}) *Compone
 	return cb
 }
 
+func (cb *ComponentBuilder) WithTextStyle(style model.TextStyleName) *ComponentBuilder {
+	return cb.WithProperty("style", string(style))
+}
+
+func (cb *ComponentBuilder) WithArrangement(arrangement model.Arrangement) *ComponentBuilder {
+	cb.component.Arrangement = &arrangement
+	return cb
+}
+
+// WithChildAlignment sets the cross-axis alignment of the component's
+// children. To position the component itself within its parent, use
+// NewModifier().Align or Aligned.
+func (cb *ComponentBuilder) WithChildAlignment(alignment model.Alignment) *ComponentBuilder {
+	cb.component.Alignment = &alignment
+	return cb
+}
+
+func (cb *ComponentBuilder) WithContentAlignment(alignment model.Alignment) *ComponentBuilder {
+	return cb.WithProperty("contentAlignment", string(alignment))
+}
+
 func (cb *ComponentBuilder) WithModifier(modifier model.ModifierConfig) *ComponentBuilder {
-	cb.component.Modifier = &modifier
+	cb.component = withModifier(cb.component, modifier)
+	return cb
+}
+
+// WithModifierChain appends modifiers that clients apply in order, the way
+// Compose does, so that e.g. padding before a background differs from after.
+func (cb *ComponentBuilder) WithModifierChain(modifiers ...model.ModifierConfig) *ComponentBuilder {
+	cb.component.ModifierChain = append(cb.component.ModifierChain, modifiers...)
 	return cb
 }
 
@@ -56,6 +84,41 @@ func (cb *ComponentBuilder) WithItemTemplate(template model.ItemTemplate) *Compo
 	return cb
 }
 
+func (cb *ComponentBuilder) WithLoadingTemplate(loading model.LoadingState) *ComponentBuilder {
+	cb.component.LoadingTemplate = &loading
+	return cb
+}
+
+func (cb *ComponentBuilder) WithEmptyTemplate(empty model.ComponentNode) *ComponentBuilder {
+	cb.component.EmptyTemplate = &empty
+	return cb
+}
+
+func (cb *ComponentBuilder) WithErrorTemplate(errorTemplate model.ComponentNode) *ComponentBuilder {
+	cb.component.ErrorTemplate = &errorTemplate
+	return cb
+}
+
+func (cb *ComponentBuilder) WithColumns(columns int) *ComponentBuilder {
+	cb.component.Columns = &columns
+	return cb
+}
+
+func (cb *ComponentBuilder) WithMinCellWidth(width model.Dimension) *ComponentBuilder {
+	cb.component.MinCellWidth = &width
+	return cb
+}
+
+// WithSpan sets how many grid columns items with the given component_type
+// occupy; use model.FullLineSpan to fill the whole row.
+func (cb *ComponentBuilder) WithSpan(componentType string, span int) *ComponentBuilder {
+	if cb.component.Spans == nil {
+		cb.component.Spans = make(map[string]int)
+	}
+	cb.component.Spans[componentType] = span
+	return cb
+}
+
 func (cb *ComponentBuilder) Build() model.ComponentNode {
 	return cb.component
 }
@@ -100,10 +163,10 @@ func Text(text string) model.ComponentNode {
 		Build()
 }
 
-func StyledText(text, style string) model.ComponentNode {
+func StyledText(text string, style model.TextStyleName) model.ComponentNode {
 	return NewComponent("text").
 		WithProperty("text", text).
-		WithProperty("style", style).
+		WithTextStyle(style).
 		Build()
 }
 
@@ -146,12 +209,62 @@ func Row(children ...model.ComponentNode) model.ComponentNode {
 		Build()
 }
 
+func SpacedBy(space int) model.Arrangement {
+	return model.SpacedBy(Dp(float32(space)))
+}
+
 func Card(children ...model.ComponentNode) model.ComponentNode {
 	return NewComponent("card").
 		WithChildren(children...).
 		Build()
 }
 
+func Box(children ...model.ComponentNode) model.ComponentNode {
+	return NewComponent("box").
+		WithChildren(children...).
+		Build()
+}
+
+func AlignedBox(contentAlignment model.Alignment, children ...model.ComponentNode) model.ComponentNode {
+	return NewComponent("box").
+		WithContentAlignment(contentAlignment).
+		WithChildren(children...).
+		Build()
+}
+
+// Stack layers its children like Box but pins the z-order to declaration
+// order, so later children are drawn on top even if a client sorts by zIndex.
+func Stack(children ...model.ComponentNode) model.ComponentNode {
+	layered := make([]model.ComponentNode, len(children))
+	for i, child := range children {
+		if child.Modifier != nil && child.Modifier.ZIndex != nil {
+			layered[i] = child
+			continue
+		}
+		layered[i] = ZIndexed(child, float32(i))
+	}
+	return NewComponent("stack").
+		WithChildren(layered...).
+		Build()
+}
+
+// Aligned positions node within a Box or Stack parent.
+func Aligned(node model.ComponentNode, alignment model.Alignment) model.ComponentNode {
+	return withModifier(node, model.ModifierConfig{Alignment: &alignment})
+}
+
+func ZIndexed(node model.ComponentNode, zIndex float32) model.ComponentNode {
+	return withModifier(node, model.ModifierConfig{ZIndex: &zIndex})
+}
+
+func withModifier(node model.ComponentNode, modifier model.ModifierConfig) model.ComponentNode {
+	if node.Modifier != nil {
+		modifier = MergeModifiers(*node.Modifier, modifier)
+	}
+	node.Modifier = &modifier
+	return node
+}
+
 func CardWithElevation(elevation float32, children ...model.ComponentNode) model.ComponentNode {
 	return NewComponent("card").
 		WithProperty("elevation", elevation).
@@ -160,6 +273,10 @@ func CardWithElevation(elevation float32, children ...model.ComponentNode) model
 }
 
 func Spacer(height int) model.ComponentNode {
+	return SpacerOf(Dp(float32(height)))
+}
+
+func SpacerOf(height model.Dimension) model.ComponentNode {
 	return NewComponent("spacer").
 		WithProperty("height", height).
 		Build()
@@ -167,7 +284,7 @@ func Spacer(height int) model.ComponentNode {
 
 func SpacerItem(height int) map[string]interface{} {
 	return ItemWithComponentType(map[string]interface{}{
-		"height": height,
+		"height": Dp(float32(height)),
 	}, "spacer")
 }
 
@@ -283,6 +400,7 @@ func LazyColumn(dataSource model.DataSource, itemTemplate model.ItemTemplate) mo
 	return NewComponent("lazy_column").
 		WithDataSource(dataSource).
 		WithItemTemplate(itemTemplate).
+		withDefaultSkeleton().
 		Build()
 }
 
@@ -290,9 +408,43 @@ func LazyRow(dataSource model.DataSource, itemTemplate model.ItemTemplate) model
 	return NewComponent("lazy_row").
 		WithDataSource(dataSource).
 		WithItemTemplate(itemTemplate).
+		withDefaultSkeleton().
 		Build()
 }
 
+type GridCells struct {
+	columns      *int
+	minCellWidth *model.Dimension
+}
+
+func FixedColumns(columns int) GridCells {
+	return GridCells{columns: &columns}
+}
+
+func AdaptiveColumns(minCellWidth model.Dimension) GridCells {
+	return GridCells{minCellWidth: &minCellWidth}
+}
+
+// LazyGrid returns a builder rather than a node so that per-type spans can
+// be chained with WithSpan.
+func LazyGrid(cells GridCells, dataSource model.DataSource, itemTemplate model.ItemTemplate) *ComponentBuilder {
+	cb := NewComponent("lazy_vertical_grid").
+		WithDataSource(dataSource).
+		WithItemTemplate(itemTemplate).
+		withDefaultSkeleton()
+	cb.component.Columns = cells.columns
+	cb.component.MinCellWidth = cells.minCellWidth
+	return cb
+}
+
+func LazyVerticalGrid(columns int, dataSource model.DataSource, itemTemplate model.ItemTemplate) model.ComponentNode {
+	return LazyGrid(FixedColumns(columns), dataSource, itemTemplate).Build()
+}
+
+func AdaptiveLazyVerticalGrid(minCellWidth model.Dimension, dataSource model.DataSource, itemTemplate model.ItemTemplate) model.ComponentNode {
+	return LazyGrid(AdaptiveColumns(minCellWidth), dataSource, itemTemplate).Build()
+}
+
 func NavigationAction(destination string) model.ActionConfig {
 	return model.ActionConfig{
 		Type:        "navigation",
@@ -308,6 +460,8 @@ func NavigationActionWithParams(destination string, params map[string]string) mo
 	}
 }
 
+// Deprecated: APICallAction carries no endpoint or method and fails
+// validation; use APICall or APICallTo.
 func APICallAction() model.ActionConfig {
 	return model.ActionConfig{
 		Type: "api_call",
@@ -316,7 +470,7 @@ func APICallAction() model.ActionConfig {
 
 func PaddingModifier(padding int) model.ModifierConfig {
 	return model.ModifierConfig{
-		Padding: &padding,
+		Padding: DpPtr(float32(padding)),
 	}
 }
 
@@ -335,6 +489,13 @@ func FillMaxSizeModifier() model.ModifierConfig {
 }
 
 func SizeModifier(width, height int) model.ModifierConfig {
+	return model.ModifierConfig{
+		Width:  DpPtr(float32(width)),
+		Height: DpPtr(float32(height)),
+	}
+}
+
+func SizeModifierOf(width, height model.Dimension) model.ModifierConfig {
 	return model.ModifierConfig{
 		Width:  &width,
 		Height: &height,
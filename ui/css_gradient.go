@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/color"
+	"github.com/nicholaspark09/ssr-go/model"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var sideAngles = map[string]float32{
+	"to top":          0,
+	"to top right":    45,
+	"to right top":    45,
+	"to right":        90,
+	"to bottom right": 135,
+	"to right bottom": 135,
+	"to bottom":       180,
+	"to bottom left":  225,
+	"to left bottom":  225,
+	"to left":         270,
+	"to top left":     315,
+	"to left top":     315,
+}
+
+var positionKeywords = map[string]float32{
+	"left":   0,
+	"top":    0,
+	"center": 0.5,
+	"right":  1,
+	"bottom": 1,
+}
+
+// ParseCSSGradient converts a CSS linear-gradient, radial-gradient or
+// conic-gradient string into a GradientConfig. Stop colors are normalized.
+func ParseCSSGradient(css string) (model.GradientConfig, error) {
+	value := strings.TrimSpace(css)
+	open := strings.Index(value, "(")
+	if open < 0 || !strings.HasSuffix(value, ")") {
+		return model.GradientConfig{}, fmt.Errorf("invalid CSS gradient %q", css)
+	}
+	function := strings.ToLower(strings.TrimSpace(value[:open]))
+	args := splitTopLevel(value[open+1 : len(value)-1])
+	if len(args) == 0 {
+		return model.GradientConfig{}, fmt.Errorf("invalid CSS gradient %q: no color stops", css)
+	}
+
+	var gradient model.GradientConfig
+	var err error
+	switch function {
+	case "linear-gradient":
+		gradient, args, err = parseLinearPrelude(args)
+	case "radial-gradient":
+		gradient, args, err = parseRadialPrelude(args)
+	case "conic-gradient":
+		gradient, args, err = parseConicPrelude(args)
+	default:
+		return model.GradientConfig{}, fmt.Errorf("unsupported CSS gradient function %q", function)
+	}
+	if err != nil {
+		return model.GradientConfig{}, fmt.Errorf("invalid CSS gradient %q: %w", css, err)
+	}
+
+	stops := make([]model.ColorStop, 0, len(args))
+	for _, arg := range args {
+		stop, err := parseCSSStop(arg)
+		if err != nil {
+			return model.GradientConfig{}, fmt.Errorf("invalid CSS gradient %q: %w", css, err)
+		}
+		stops = append(stops, stop)
+	}
+	if len(stops) < 2 {
+		return model.GradientConfig{}, fmt.Errorf("invalid CSS gradient %q: at least two color stops are required", css)
+	}
+	applyStops(&gradient, stops)
+	return gradient, nil
+}
+
+func parseLinearPrelude(args []string) (model.GradientConfig, []string, error) {
+	// CSS defaults to "to bottom" when the direction is omitted.
+	angle := float32(180)
+	first := strings.ToLower(args[0])
+	if a, ok := sideAngles[strings.Join(strings.Fields(first), " ")]; ok {
+		angle, args = a, args[1:]
+	} else if a, err := parseCSSAngle(first); err == nil {
+		angle, args = a, args[1:]
+	}
+	return model.GradientConfig{Type: "linear", Angle: &angle}, args, nil
+}
+
+func parseRadialPrelude(args []string) (model.GradientConfig, []string, error) {
+	gradient := model.GradientConfig{Type: "radial"}
+	fields := strings.Fields(strings.ToLower(args[0]))
+	if len(fields) == 0 || !isRadialPrelude(fields) {
+		return gradient, args, nil
+	}
+
+	shape, at := fields, []string(nil)
+	for i, field := range fields {
+		if field == "at" {
+			shape, at = fields[:i], fields[i+1:]
+			break
+		}
+	}
+	for _, field := range shape {
+		switch field {
+		case "circle", "ellipse", "closest-side", "closest-corner", "farthest-side", "farthest-corner":
+		default:
+			radius, err := parseLength(field)
+			if err != nil {
+				return gradient, nil, fmt.Errorf("unsupported radial size %q", field)
+			}
+			gradient.Radius = &radius
+		}
+	}
+	if at != nil {
+		x, y, err := parseCSSPosition(at)
+		if err != nil {
+			return gradient, nil, err
+		}
+		gradient.CenterX, gradient.CenterY = &x, &y
+	}
+	return gradient, args[1:], nil
+}
+
+func parseConicPrelude(args []string) (model.GradientConfig, []string, error) {
+	gradient := model.GradientConfig{Type: "sweep"}
+	fields := strings.Fields(strings.ToLower(args[0]))
+	if len(fields) == 0 || (fields[0] != "from" && fields[0] != "at") {
+		return gradient, args, nil
+	}
+	if fields[0] == "from" {
+		// GradientConfig only carries an angle for linear gradients.
+		if len(fields) < 2 {
+			return gradient, nil, fmt.Errorf("missing conic start angle")
+		}
+		if a, err := parseCSSAngle(fields[1]); err != nil || a != 0 {
+			return gradient, nil, fmt.Errorf("conic start angles are not supported")
+		}
+		fields = fields[2:]
+	}
+	if len(fields) > 0 {
+		if fields[0] != "at" {
+			return gradient, nil, fmt.Errorf("unexpected %q in conic gradient", fields[0])
+		}
+		x, y, err := parseCSSPosition(fields[1:])
+		if err != nil {
+			return gradient, nil, err
+		}
+		gradient.CenterX, gradient.CenterY = &x, &y
+	}
+	return gradient, args[1:], nil
+}
+
+func isRadialPrelude(fields []string) bool {
+	switch fields[0] {
+	case "circle", "ellipse", "at", "closest-side", "closest-corner", "farthest-side", "farthest-corner":
+		return true
+	}
+	_, err := parseLength(fields[0])
+	return err == nil
+}
+
+func parseCSSStop(arg string) (model.ColorStop, error) {
+	// The color may itself contain spaces, e.g. "rgb(0 0 0) 50%".
+	colorPart, positionPart := arg, ""
+	if i := strings.LastIndexAny(arg, " \t"); i >= 0 && !strings.HasSuffix(arg, ")") {
+		colorPart, positionPart = strings.TrimSpace(arg[:i]), strings.TrimSpace(arg[i+1:])
+	}
+	normalized, err := color.Normalize(colorPart)
+	if err != nil {
+		return model.ColorStop{}, err
+	}
+	stop := model.ColorStop{Color: normalized}
+	if positionPart != "" {
+		if !strings.HasSuffix(positionPart, "%") {
+			return model.ColorStop{}, fmt.Errorf("color stop position %q must be a percentage", positionPart)
+		}
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(positionPart, "%"), 32)
+		if err != nil {
+			return model.ColorStop{}, fmt.Errorf("invalid color stop position %q", positionPart)
+		}
+		position := float32(percent / 100)
+		stop.Position = &position
+	}
+	return stop, nil
+}
+
+func parseCSSAngle(value string) (float32, error) {
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"grad", 0.9},
+		{"turn", 360},
+		{"rad", 180 / math.Pi},
+		{"deg", 1},
+	}
+	for _, unit := range units {
+		if strings.HasSuffix(value, unit.suffix) {
+			v, err := strconv.ParseFloat(strings.TrimSuffix(value, unit.suffix), 64)
+			if err != nil {
+				return 0, err
+			}
+			return float32(v * unit.scale), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid angle %q", value)
+}
+
+func parseLength(value string) (float32, error) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(value, "px"), 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid length %q", value)
+	}
+	return float32(v), nil
+}
+
+// parseCSSPosition returns the center as fractions of the box, which is how
+// GradientConfig expresses coordinates. As in CSS, left and right always set
+// X and top and bottom always set Y, so "top right" equals "right top".
+func parseCSSPosition(fields []string) (float32, float32, error) {
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, 0, fmt.Errorf("invalid gradient position %q", strings.Join(fields, " "))
+	}
+	fields = append([]string(nil), fields...)
+	if len(fields) == 1 {
+		fields = append(fields, "center")
+		if isVerticalKeyword(fields[0]) {
+			fields[0], fields[1] = fields[1], fields[0]
+		}
+	} else if isVerticalKeyword(fields[0]) || isHorizontalKeyword(fields[1]) {
+		fields[0], fields[1] = fields[1], fields[0]
+	}
+	if isVerticalKeyword(fields[0]) || isHorizontalKeyword(fields[1]) {
+		return 0, 0, fmt.Errorf("invalid gradient position %q", strings.Join(fields, " "))
+	}
+
+	var coordinates [2]float32
+	for i, field := range fields {
+		if v, ok := positionKeywords[field]; ok {
+			coordinates[i] = v
+			continue
+		}
+		if !strings.HasSuffix(field, "%") {
+			return 0, 0, fmt.Errorf("gradient position %q must be a keyword or percentage", field)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSuffix(field, "%"), 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid gradient position %q", field)
+		}
+		coordinates[i] = float32(v / 100)
+	}
+	return coordinates[0], coordinates[1], nil
+}
+
+func isHorizontalKeyword(field string) bool {
+	return field == "left" || field == "right"
+}
+
+func isVerticalKeyword(field string) bool {
+	return field == "top" || field == "bottom"
+}
+
+// splitTopLevel splits on commas that are not nested inside parentheses.
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		parts = append(parts, last)
+	}
+	return parts
+}
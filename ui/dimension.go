@@ -0,0 +1,35 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+func Dp(value float32) model.Dimension {
+	return model.Dimension{Value: value, Unit: model.Dp}
+}
+
+func Sp(value float32) model.Dimension {
+	return model.Dimension{Value: value, Unit: model.Sp}
+}
+
+func Px(value float32) model.Dimension {
+	return model.Dimension{Value: value, Unit: model.Px}
+}
+
+func Percent(value float32) model.Dimension {
+	return model.Dimension{Value: value, Unit: model.Percent}
+}
+
+func WrapContent() model.Dimension {
+	return model.Dimension{Unit: model.Wrap}
+}
+
+func FillParent() model.Dimension {
+	return model.Dimension{Unit: model.Fill}
+}
+
+func DimensionPtr(d model.Dimension) *model.Dimension {
+	return &d
+}
+
+func DpPtr(value float32) *model.Dimension {
+	return DimensionPtr(Dp(value))
+}
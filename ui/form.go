@@ -0,0 +1,105 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+// Form inputs return builders so rules, initial values and modifiers can be
+// chained before Build.
+
+func TextField(name, label string) *ComponentBuilder {
+	return formField("text_field", name, label)
+}
+
+func PasswordField(name, label string) *ComponentBuilder {
+	return formField("password_field", name, label)
+}
+
+func NumberField(name, label string) *ComponentBuilder {
+	return formField("number_field", name, label)
+}
+
+func Checkbox(name, label string) *ComponentBuilder {
+	return formField("checkbox", name, label).WithInitialValue(false)
+}
+
+func Switch(name, label string) *ComponentBuilder {
+	return formField("switch", name, label).WithInitialValue(false)
+}
+
+func RadioGroup(name, label string, options ...model.FieldOption) *ComponentBuilder {
+	return formField("radio_group", name, label).WithProperty("options", options)
+}
+
+func Dropdown(name, label string, options ...model.FieldOption) *ComponentBuilder {
+	return formField("dropdown", name, label).WithProperty("options", options)
+}
+
+func Slider(name, label string, min, max, step float64) *ComponentBuilder {
+	return formField("slider", name, label).
+		WithProperty("min", min).
+		WithProperty("max", max).
+		WithProperty("step", step).
+		WithInitialValue(min)
+}
+
+// DatePicker values are ISO 8601 dates (2006-01-02).
+func DatePicker(name, label string) *ComponentBuilder {
+	return formField("date_picker", name, label)
+}
+
+func Option(label, value string) model.FieldOption {
+	return model.FieldOption{Label: label, Value: value}
+}
+
+func formField(fieldType, name, label string) *ComponentBuilder {
+	return NewComponent(fieldType).
+		WithProperty("name", name).
+		WithProperty("label", label)
+}
+
+func (cb *ComponentBuilder) WithInitialValue(value interface{}) *ComponentBuilder {
+	return cb.WithProperty("value", value)
+}
+
+func (cb *ComponentBuilder) WithPlaceholder(placeholder string) *ComponentBuilder {
+	return cb.WithProperty("placeholder", placeholder)
+}
+
+func (cb *ComponentBuilder) WithRules(rules ...model.ValidationRule) *ComponentBuilder {
+	existing, _ := cb.component.Properties["rules"].([]model.ValidationRule)
+	return cb.WithProperty("rules", append(existing, rules...))
+}
+
+func Required(message string) model.ValidationRule {
+	return model.ValidationRule{Type: "required", Message: message}
+}
+
+func Min(min float64, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "min", Value: min, Message: message}
+}
+
+func Max(max float64, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "max", Value: max, Message: message}
+}
+
+// MinDate and MaxDate bound a date picker with ISO 8601 dates.
+func MinDate(date, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "min", Value: date, Message: message}
+}
+
+func MaxDate(date, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "max", Value: date, Message: message}
+}
+
+func MinLength(length int, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "minLength", Value: length, Message: message}
+}
+
+func MaxLength(length int, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "maxLength", Value: length, Message: message}
+}
+
+// Pattern uses RE2 syntax, which is a subset of the regular expressions
+// understood by JavaScript, Kotlin and Swift clients.
+func Pattern(pattern, message string) model.ValidationRule {
+	return model.ValidationRule{Type: "pattern", Value: pattern, Message: message}
+}
@@ -0,0 +1,115 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+func Stop(color string, position float32) model.ColorStop {
+	return model.ColorStop{Color: color, Position: &position}
+}
+
+func Stops(colors ...string) []model.ColorStop {
+	stops := make([]model.ColorStop, len(colors))
+	for i, color := range colors {
+		stops[i] = model.ColorStop{Color: color}
+	}
+	return stops
+}
+
+func LinearGradient(angle float32, stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{Type: "linear", Angle: &angle}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func LinearGradientBetween(startX, startY, endX, endY float32, stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{
+		Type:   "linear",
+		StartX: &startX,
+		StartY: &startY,
+		EndX:   &endX,
+		EndY:   &endY,
+	}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func RadialGradient(radius float32, stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{Type: "radial", Radius: &radius}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func RadialGradientAt(centerX, centerY, radius float32, stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{
+		Type:    "radial",
+		Radius:  &radius,
+		CenterX: &centerX,
+		CenterY: &centerY,
+	}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func SweepGradient(stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{Type: "sweep"}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func SweepGradientAt(centerX, centerY float32, stops ...model.ColorStop) model.GradientConfig {
+	gradient := model.GradientConfig{
+		Type:    "sweep",
+		CenterX: &centerX,
+		CenterY: &centerY,
+	}
+	applyStops(&gradient, stops)
+	return gradient
+}
+
+func GradientModifier(gradient model.GradientConfig) model.ModifierConfig {
+	return model.ModifierConfig{
+		Gradient: &gradient,
+	}
+}
+
+// applyStops fills Colors and, when any stop carries a position, Positions.
+// Missing positions follow CSS rules: the ends default to 0 and 1 and the
+// stops in between are spread evenly.
+func applyStops(gradient *model.GradientConfig, stops []model.ColorStop) {
+	gradient.Colors = make([]string, len(stops))
+	positioned := false
+	for i, stop := range stops {
+		gradient.Colors[i] = stop.Color
+		positioned = positioned || stop.Position != nil
+	}
+	if !positioned {
+		return
+	}
+
+	positions := make([]float32, len(stops))
+	known := make([]bool, len(stops))
+	for i, stop := range stops {
+		if stop.Position != nil {
+			positions[i], known[i] = *stop.Position, true
+		}
+	}
+	if !known[0] {
+		positions[0], known[0] = 0, true
+	}
+	if last := len(stops) - 1; !known[last] {
+		positions[last], known[last] = 1, true
+	}
+	for i := 1; i < len(stops); i++ {
+		if known[i] {
+			continue
+		}
+		next := i
+		for !known[next] {
+			next++
+		}
+		step := (positions[next] - positions[i-1]) / float32(next-i+1)
+		for j := i; j < next; j++ {
+			positions[j], known[j] = positions[j-1]+step, true
+		}
+	}
+	gradient.Positions = positions
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"reflect"
+	"testing"
+)
+
+func TestParseCSSGradient(t *testing.T) {
+	tests := []struct {
+		name string
+		css  string
+		want model.GradientConfig
+	}{
+		{
+			name: "Linear with angle and positions",
+			css:  "linear-gradient(45deg, #fff 0%, #000 100%)",
+			want: LinearGradient(45, Stop("#FFFFFF", 0), Stop("#000000", 1)),
+		},
+		{
+			name: "Linear with side keyword",
+			css:  "linear-gradient(to right, rgb(255, 0, 0), hsl(240, 100%, 50%))",
+			want: LinearGradient(90, Stops("#FF0000", "#0000FF")...),
+		},
+		{
+			name: "Linear with default direction",
+			css:  "linear-gradient(#f00, #0f0 25%, #00f)",
+			want: LinearGradient(180, model.ColorStop{Color: "#FF0000"}, Stop("#00FF00", 0.25), model.ColorStop{Color: "#0000FF"}),
+		},
+		{
+			name: "Radial with size and center",
+			css:  "radial-gradient(circle 120px at 25% 75%, #fff, #000)",
+			want: RadialGradientAt(0.25, 0.75, 120, Stops("#FFFFFF", "#000000")...),
+		},
+		{
+			name: "Radial at top right",
+			css:  "radial-gradient(circle at top right, #fff, #000)",
+			want: model.GradientConfig{Type: "radial", Colors: []string{"#FFFFFF", "#000000"}, CenterX: Float32Ptr(1), CenterY: Float32Ptr(0)},
+		},
+		{
+			name: "Radial at right top",
+			css:  "radial-gradient(circle at right top, #fff, #000)",
+			want: model.GradientConfig{Type: "radial", Colors: []string{"#FFFFFF", "#000000"}, CenterX: Float32Ptr(1), CenterY: Float32Ptr(0)},
+		},
+		{
+			name: "Radial at bottom",
+			css:  "radial-gradient(circle at bottom, #fff, #000)",
+			want: model.GradientConfig{Type: "radial", Colors: []string{"#FFFFFF", "#000000"}, CenterX: Float32Ptr(0.5), CenterY: Float32Ptr(1)},
+		},
+		{
+			name: "Conic at center",
+			css:  "conic-gradient(at center, #fff, #000)",
+			want: SweepGradientAt(0.5, 0.5, Stops("#FFFFFF", "#000000")...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCSSGradient(tt.css)
+			if err != nil {
+				t.Fatalf("Failed to parse gradient: %v", err)
+			}
+
+			bytes, err := json.Marshal(GradientModifier(got))
+			if err != nil {
+				t.Fatalf("Failed to marshal gradient: %v", err)
+			}
+			utils.PrettyPrintJSON(t, string(bytes), tt.name)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(tt.want)
+				t.Errorf("ParseCSSGradient(%q)\n got: %s\nwant: %s", tt.css, gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestParseCSSGradientErrors(t *testing.T) {
+	for _, css := range []string{
+		"linear-gradient(45deg, #fff)",
+		"linear-gradient(45deg, orange, #000)",
+		"repeating-linear-gradient(#fff, #000)",
+		"conic-gradient(from 90deg, #fff, #000)",
+		"linear-gradient(#fff 0.5, #000)",
+		"radial-gradient(circle at left right, #fff, #000)",
+		"radial-gradient(circle at top bottom, #fff, #000)",
+	} {
+		if _, err := ParseCSSGradient(css); err == nil {
+			t.Errorf("Expected %q to be rejected", css)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"strings"
+	"testing"
+)
+
+func TestLazyGrids(t *testing.T) {
+	productTemplate := model.ItemTemplate{
+		Type: "product",
+		Layout: Card(
+			Image("{{imageUrl}}"),
+			Text("{{name}}"),
+		),
+	}
+
+	tests := []struct {
+		name      string
+		component model.ComponentNode
+		validate  func(t *testing.T, json string)
+	}{
+		{
+			name: "Fixed Column Product Gallery",
+			component: LazyGrid(
+				FixedColumns(2),
+				APIDataSourceWithPagination("https://api.example.com/products", "GET", 20),
+				productTemplate,
+			).
+				WithSpan("section_header", model.FullLineSpan).
+				WithSpan("promo", 2).
+				Build(),
+			validate: func(t *testing.T, json string) {
+				if !strings.Contains(json, `"type":"lazy_vertical_grid"`) {
+					t.Error("Missing grid type")
+				}
+				if !strings.Contains(json, `"columns":2`) {
+					t.Error("Missing column count")
+				}
+				if !strings.Contains(json, `"spans":{"promo":2,"section_header":-1}`) {
+					t.Error("Missing spans")
+				}
+			},
+		},
+		{
+			name: "Adaptive Photo Wall",
+			component: AdaptiveLazyVerticalGrid(
+				Dp(120),
+				StaticDataSource([]map[string]interface{}{
+					{"imageUrl": "https://example.com/1.jpg"},
+					{"imageUrl": "https://example.com/2.jpg"},
+				}),
+				model.ItemTemplate{Type: "photo", Layout: Image("{{imageUrl}}")},
+			),
+			validate: func(t *testing.T, json string) {
+				if !strings.Contains(json, `"minCellWidth":"120dp"`) {
+					t.Error("Missing adaptive min cell width")
+				}
+				if !strings.Contains(json, `"columns":null`) {
+					t.Error("Adaptive grid should not set a column count")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytes, err := json.Marshal(tt.component)
+			if err != nil {
+				t.Fatalf("Failed to marshal component: %v", err)
+			}
+
+			jsonStr := string(bytes)
+			utils.PrettyPrintJSON(t, jsonStr, tt.name)
+
+			if tt.validate != nil {
+				tt.validate(t, jsonStr)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+// MultiItemTemplate builds an item template that picks a layout by each
+// item's component_type, falling back to fallback for unknown types.
+func MultiItemTemplate(templateType string, fallback model.ComponentNode, templates map[string]model.ComponentNode) model.ItemTemplate {
+	return model.ItemTemplate{
+		Type:      templateType,
+		Layout:    fallback,
+		Templates: templates,
+	}
+}
+
+// WithItemTemplateFor registers layout for items whose component_type is
+// componentType. If the component has no item template yet, the first type
+// registered is also the Default for items of other types.
+func (cb *ComponentBuilder) WithItemTemplateFor(componentType string, layout model.ComponentNode) *ComponentBuilder {
+	if cb.component.ItemTemplate == nil {
+		cb.component.ItemTemplate = &model.ItemTemplate{Type: "default", Default: componentType}
+	}
+	if cb.component.ItemTemplate.Templates == nil {
+		cb.component.ItemTemplate.Templates = make(map[string]model.ComponentNode)
+	}
+	cb.component.ItemTemplate.Templates[componentType] = layout
+	return cb
+}
+
+// DeduplicateItemTemplates returns a copy of node in which every template
+// embedded in a data source item with ItemWithTemplate is moved into the
+// list's ItemTemplate.Templates and referenced by component_type. Identical
+// layouts share one entry, so a template is serialized once per list rather
+// than once per item. Items that already name a component_type keep it and
+// their embedded template. The input node is not modified.
+func DeduplicateItemTemplates(node model.ComponentNode) model.ComponentNode {
+	if node.Children != nil {
+		children := make([]model.ComponentNode, len(node.Children))
+		for i, child := range node.Children {
+			children[i] = DeduplicateItemTemplates(child)
+		}
+		node.Children = children
+	}
+	if node.EmptyTemplate != nil {
+		node.EmptyTemplate = NodePtr(DeduplicateItemTemplates(*node.EmptyTemplate))
+	}
+	if node.ErrorTemplate != nil {
+		node.ErrorTemplate = NodePtr(DeduplicateItemTemplates(*node.ErrorTemplate))
+	}
+	if node.Sections != nil {
+		sections := *node.Sections
+		sections.HeaderTemplate = DeduplicateItemTemplates(sections.HeaderTemplate)
+		node.Sections = &sections
+	}
+	if node.ItemTemplate != nil {
+		itemTemplate := *node.ItemTemplate
+		itemTemplate.Layout = DeduplicateItemTemplates(itemTemplate.Layout)
+		if itemTemplate.Templates != nil {
+			templates := make(map[string]model.ComponentNode, len(itemTemplate.Templates))
+			for name, template := range itemTemplate.Templates {
+				templates[name] = DeduplicateItemTemplates(template)
+			}
+			itemTemplate.Templates = templates
+		}
+		node.ItemTemplate = &itemTemplate
+	}
+	if node.DataSource != nil {
+		extractEmbeddedTemplates(&node)
+	}
+	return node
+}
+
+func extractEmbeddedTemplates(node *model.ComponentNode) {
+	embedded := false
+	for _, item := range node.DataSource.Items {
+		if _, ok := extractable(item); ok {
+			embedded = true
+			break
+		}
+	}
+	if !embedded {
+		return
+	}
+
+	itemTemplate := model.ItemTemplate{Type: "default"}
+	if node.ItemTemplate != nil {
+		itemTemplate = *node.ItemTemplate
+	}
+	templates := make(map[string]model.ComponentNode, len(itemTemplate.Templates))
+	names := make(map[string]string)
+	for name, template := range itemTemplate.Templates {
+		templates[name] = template
+		fingerprint, err := templateFingerprint(template)
+		if err != nil {
+			continue
+		}
+		if existing, ok := names[fingerprint]; !ok || name < existing {
+			names[fingerprint] = name
+		}
+	}
+
+	dataSource := *node.DataSource
+	dataSource.Items = make([]map[string]interface{}, len(node.DataSource.Items))
+	for i, item := range node.DataSource.Items {
+		template, ok := extractable(item)
+		if !ok {
+			dataSource.Items[i] = item
+			continue
+		}
+		template = DeduplicateItemTemplates(template)
+		fingerprint, err := templateFingerprint(template)
+		if err != nil {
+			dataSource.Items[i] = item
+			continue
+		}
+		name, known := names[fingerprint]
+		if !known {
+			name = "template_" + fingerprint[:12]
+			names[fingerprint] = name
+			templates[name] = template
+		}
+
+		rewritten := make(map[string]interface{}, len(item))
+		for k, v := range item {
+			if k != "template" {
+				rewritten[k] = v
+			}
+		}
+		rewritten["component_type"] = name
+		dataSource.Items[i] = rewritten
+	}
+
+	itemTemplate.Templates = templates
+	node.ItemTemplate = &itemTemplate
+	node.DataSource = &dataSource
+}
+
+// extractable returns the template embedded in item unless the item already
+// names a component_type, which would be lost by moving the template out.
+func extractable(item map[string]interface{}) (model.ComponentNode, bool) {
+	template, ok := item["template"].(model.ComponentNode)
+	if !ok {
+		return model.ComponentNode{}, false
+	}
+	if _, typed := item["component_type"]; typed {
+		return model.ComponentNode{}, false
+	}
+	return template, true
+}
+
+func templateFingerprint(template model.ComponentNode) (string, error) {
+	bytes, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,82 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+type ListStates struct {
+	Loading *model.LoadingState
+	Empty   *model.ComponentNode
+	Error   *model.ComponentNode
+}
+
+func (cb *ComponentBuilder) WithListStates(states ListStates) *ComponentBuilder {
+	if states.Loading != nil {
+		cb.WithLoadingTemplate(*states.Loading)
+	}
+	if states.Empty != nil {
+		cb.WithEmptyTemplate(*states.Empty)
+	}
+	if states.Error != nil {
+		cb.WithErrorTemplate(*states.Error)
+	}
+	return cb
+}
+
+func LazyColumnWithStates(dataSource model.DataSource, itemTemplate model.ItemTemplate, states ListStates) model.ComponentNode {
+	return NewComponent("lazy_column").
+		WithDataSource(dataSource).
+		WithItemTemplate(itemTemplate).
+		WithListStates(states).
+		withDefaultSkeleton().
+		Build()
+}
+
+func LazyRowWithStates(dataSource model.DataSource, itemTemplate model.ItemTemplate, states ListStates) model.ComponentNode {
+	return NewComponent("lazy_row").
+		WithDataSource(dataSource).
+		WithItemTemplate(itemTemplate).
+		WithListStates(states).
+		withDefaultSkeleton().
+		Build()
+}
+
+func SpinnerLoading() model.LoadingState {
+	return model.LoadingState{Type: "spinner"}
+}
+
+func ShimmerLoading(count int) model.LoadingState {
+	return model.LoadingState{Type: "shimmer", Count: &count}
+}
+
+func SkeletonLoading(count int) model.LoadingState {
+	return model.LoadingState{Type: "skeleton", Count: &count}
+}
+
+func EmptyState(message string) model.ComponentNode {
+	return Column(
+		StyledText(message, model.Body1),
+	).WithChildAlignment(model.AlignCenterHorizontally)
+}
+
+func EmptyStateWithAction(message, actionText string, action model.ActionConfig) model.ComponentNode {
+	return Column(
+		StyledText(message, model.Body1),
+		Spacer(16),
+		Button(actionText, action),
+	).WithChildAlignment(model.AlignCenterHorizontally)
+}
+
+func ErrorState(message, retryText string) model.ComponentNode {
+	return Column(
+		StyledText(message, model.Body1),
+		Spacer(16),
+		Button(retryText, RetryAction()),
+	).WithChildAlignment(model.AlignCenterHorizontally)
+}
+
+// RetryAction asks the client to reload the data source of the list whose
+// error template contains it.
+func RetryAction() model.ActionConfig {
+	return model.ActionConfig{
+		Type: "retry",
+	}
+}
@@ -0,0 +1,205 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+type ModifierBuilder struct {
+	modifier model.ModifierConfig
+}
+
+func NewModifier() *ModifierBuilder {
+	return &ModifierBuilder{}
+}
+
+func (mb *ModifierBuilder) Padding(padding int) *ModifierBuilder {
+	mb.modifier.Padding = DpPtr(float32(padding))
+	return mb
+}
+
+func (mb *ModifierBuilder) PaddingOf(padding model.Dimension) *ModifierBuilder {
+	mb.modifier.Padding = &padding
+	return mb
+}
+
+func (mb *ModifierBuilder) PaddingEach(start, top, end, bottom int) *ModifierBuilder {
+	mb.modifier.PaddingStart = DpPtr(float32(start))
+	mb.modifier.PaddingTop = DpPtr(float32(top))
+	mb.modifier.PaddingEnd = DpPtr(float32(end))
+	mb.modifier.PaddingBottom = DpPtr(float32(bottom))
+	return mb
+}
+
+func (mb *ModifierBuilder) PaddingSymmetric(horizontal, vertical int) *ModifierBuilder {
+	return mb.PaddingEach(horizontal, vertical, horizontal, vertical)
+}
+
+func (mb *ModifierBuilder) FillMaxSize() *ModifierBuilder {
+	mb.modifier.FillMaxSize = BoolPtr(true)
+	return mb
+}
+
+func (mb *ModifierBuilder) FillMaxWidth() *ModifierBuilder {
+	mb.modifier.FillMaxWidth = BoolPtr(true)
+	return mb
+}
+
+func (mb *ModifierBuilder) Size(width, height int) *ModifierBuilder {
+	mb.modifier.Width = DpPtr(float32(width))
+	mb.modifier.Height = DpPtr(float32(height))
+	return mb
+}
+
+func (mb *ModifierBuilder) SizeOf(width, height model.Dimension) *ModifierBuilder {
+	mb.modifier.Width = &width
+	mb.modifier.Height = &height
+	return mb
+}
+
+func (mb *ModifierBuilder) WidthOf(width model.Dimension) *ModifierBuilder {
+	mb.modifier.Width = &width
+	return mb
+}
+
+func (mb *ModifierBuilder) HeightOf(height model.Dimension) *ModifierBuilder {
+	mb.modifier.Height = &height
+	return mb
+}
+
+func (mb *ModifierBuilder) Width(width int) *ModifierBuilder {
+	mb.modifier.Width = DpPtr(float32(width))
+	return mb
+}
+
+func (mb *ModifierBuilder) Height(height int) *ModifierBuilder {
+	mb.modifier.Height = DpPtr(float32(height))
+	return mb
+}
+
+func (mb *ModifierBuilder) WidthIn(min, max int) *ModifierBuilder {
+	mb.modifier.MinWidth = DpPtr(float32(min))
+	mb.modifier.MaxWidth = DpPtr(float32(max))
+	return mb
+}
+
+func (mb *ModifierBuilder) HeightIn(min, max int) *ModifierBuilder {
+	mb.modifier.MinHeight = DpPtr(float32(min))
+	mb.modifier.MaxHeight = DpPtr(float32(max))
+	return mb
+}
+
+func (mb *ModifierBuilder) MinWidth(width int) *ModifierBuilder {
+	mb.modifier.MinWidth = DpPtr(float32(width))
+	return mb
+}
+
+func (mb *ModifierBuilder) MaxWidth(width int) *ModifierBuilder {
+	mb.modifier.MaxWidth = DpPtr(float32(width))
+	return mb
+}
+
+func (mb *ModifierBuilder) MinHeight(height int) *ModifierBuilder {
+	mb.modifier.MinHeight = DpPtr(float32(height))
+	return mb
+}
+
+func (mb *ModifierBuilder) MaxHeight(height int) *ModifierBuilder {
+	mb.modifier.MaxHeight = DpPtr(float32(height))
+	return mb
+}
+
+func (mb *ModifierBuilder) AspectRatio(ratio float32) *ModifierBuilder {
+	mb.modifier.AspectRatio = &ratio
+	return mb
+}
+
+func (mb *ModifierBuilder) Weight(weight float32) *ModifierBuilder {
+	mb.modifier.Weight = &weight
+	return mb
+}
+
+func (mb *ModifierBuilder) Offset(x, y int) *ModifierBuilder {
+	mb.modifier.OffsetX = DpPtr(float32(x))
+	mb.modifier.OffsetY = DpPtr(float32(y))
+	return mb
+}
+
+func (mb *ModifierBuilder) Align(alignment model.Alignment) *ModifierBuilder {
+	mb.modifier.Alignment = &alignment
+	return mb
+}
+
+func (mb *ModifierBuilder) ZIndex(zIndex float32) *ModifierBuilder {
+	mb.modifier.ZIndex = &zIndex
+	return mb
+}
+
+func (mb *ModifierBuilder) Background(color string) *ModifierBuilder {
+	mb.modifier.Background = &color
+	return mb
+}
+
+func (mb *ModifierBuilder) Gradient(gradient model.GradientConfig) *ModifierBuilder {
+	mb.modifier.Gradient = &gradient
+	return mb
+}
+
+func (mb *ModifierBuilder) Border(width int, color string) *ModifierBuilder {
+	return mb.BorderOf(Dp(float32(width)), color)
+}
+
+func (mb *ModifierBuilder) BorderOf(width model.Dimension, color string) *ModifierBuilder {
+	mb.modifier.Border = &model.BorderConfig{Width: width, Color: color}
+	return mb
+}
+
+func (mb *ModifierBuilder) RoundedBorder(width int, color string, radius int) *ModifierBuilder {
+	return mb.RoundedBorderOf(Dp(float32(width)), color, Dp(float32(radius)))
+}
+
+func (mb *ModifierBuilder) RoundedBorderOf(width model.Dimension, color string, radius model.Dimension) *ModifierBuilder {
+	mb.modifier.Border = &model.BorderConfig{Width: width, Color: color, Radius: &radius}
+	return mb
+}
+
+func (mb *ModifierBuilder) Clip(shape model.ShapeConfig) *ModifierBuilder {
+	mb.modifier.Shape = &shape
+	return mb
+}
+
+func (mb *ModifierBuilder) Shadow(elevation int) *ModifierBuilder {
+	mb.modifier.Shadow = &model.ShadowConfig{Elevation: elevation}
+	return mb
+}
+
+func (mb *ModifierBuilder) ShadowWith(shadow model.ShadowConfig) *ModifierBuilder {
+	mb.modifier.Shadow = &shadow
+	return mb
+}
+
+func (mb *ModifierBuilder) Alpha(alpha float32) *ModifierBuilder {
+	mb.modifier.Alpha = &alpha
+	return mb
+}
+
+func (mb *ModifierBuilder) Build() model.ModifierConfig {
+	return mb.modifier
+}
+
+func RectangleShape() model.ShapeConfig {
+	return model.ShapeConfig{Type: "rectangle"}
+}
+
+func RoundedCornerShape(radius int) model.ShapeConfig {
+	return RoundedCornerShapeOf(Dp(float32(radius)))
+}
+
+func RoundedCornerShapeOf(radius model.Dimension) model.ShapeConfig {
+	return model.ShapeConfig{Type: "rounded", CornerRadius: &radius}
+}
+
+func CircleShape() model.ShapeConfig {
+	return model.ShapeConfig{Type: "circle"}
+}
+
+func PillShape() model.ShapeConfig {
+	return model.ShapeConfig{Type: "pill"}
+}
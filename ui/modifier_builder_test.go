@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"strings"
+	"testing"
+)
+
+func TestModifierBuilder(t *testing.T) {
+	component := NewComponent("image").
+		WithProperty("url", "https://example.com/hero.jpg").
+		WithModifier(NewModifier().
+			FillMaxWidth().
+			AspectRatio(16.0/9.0).
+			HeightIn(120, 320).
+			Background("#000000").
+			RoundedBorder(1, "#E5E7EB", 12).
+			Clip(RoundedCornerShape(12)).
+			Shadow(4).
+			Alpha(0.9).
+			Align(model.AlignBottomCenter).
+			Offset(0, -8).
+			Build()).
+		Build()
+
+	bytes, err := json.Marshal(component)
+	if err != nil {
+		t.Fatalf("Failed to marshal component: %v", err)
+	}
+
+	jsonStr := string(bytes)
+	utils.PrettyPrintJSON(t, jsonStr, "Modifier Builder")
+
+	expected := []string{
+		`"fillMaxWidth":true`,
+		`"minHeight":"120dp"`,
+		`"maxHeight":"320dp"`,
+		`"background":"#000000"`,
+		`"border":{"width":"1dp","color":"#E5E7EB","radius":"12dp"}`,
+		`"shape":{"type":"rounded","cornerRadius":"12dp"}`,
+		`"elevation":4`,
+		`"alpha":0.9`,
+		`"alignment":"bottomCenter"`,
+		`"offsetY":"-8dp"`,
+	}
+	for _, want := range expected {
+		if !strings.Contains(jsonStr, want) {
+			t.Errorf("Missing %s", want)
+		}
+	}
+}
+
+func TestModifierMerging(t *testing.T) {
+	component := NewComponent("card").
+		WithModifier(PaddingModifier(16)).
+		WithModifier(FillMaxWidthModifier()).
+		WithModifier(NewModifier().Padding(24).Background("#FFFFFF").Build()).
+		Build()
+
+	modifier := component.Modifier
+	if modifier.Padding == nil || *modifier.Padding != Dp(24) {
+		t.Errorf("Expected the later padding to win, got %v", modifier.Padding)
+	}
+	if modifier.FillMaxWidth == nil || !*modifier.FillMaxWidth {
+		t.Error("Expected fillMaxWidth to survive later modifiers")
+	}
+	if modifier.Background == nil || *modifier.Background != "#FFFFFF" {
+		t.Error("Expected background to be merged in")
+	}
+
+	combined := Modifiers(SizeModifier(48, 48), PaddingModifier(8), SizeModifier(64, 64))
+	if *combined.Width != Dp(64) || *combined.Height != Dp(64) || *combined.Padding != Dp(8) {
+		t.Errorf("Unexpected combined modifier: %+v", combined)
+	}
+}
+
+func TestModifierChain(t *testing.T) {
+	component := NewComponent("text").
+		WithProperty("text", "Chip").
+		WithModifierChain(
+			PaddingModifier(4),
+			NewModifier().Background("#3B82F6").Clip(PillShape()).Build(),
+			PaddingModifier(8),
+		).
+		Build()
+
+	bytes, err := json.Marshal(component)
+	if err != nil {
+		t.Fatalf("Failed to marshal component: %v", err)
+	}
+
+	jsonStr := string(bytes)
+	utils.PrettyPrintJSON(t, jsonStr, "Modifier Chain")
+
+	if len(component.ModifierChain) != 3 {
+		t.Fatalf("Expected 3 chained modifiers, got %d", len(component.ModifierChain))
+	}
+	if !strings.Contains(jsonStr, `"modifierChain":[{"padding":"4dp"`) {
+		t.Error("Expected modifierChain to preserve order")
+	}
+}
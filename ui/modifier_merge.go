@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"reflect"
+)
+
+// MergeModifiers overlays override on base: every field set in override wins,
+// every field left nil keeps the value from base.
+func MergeModifiers(base, override model.ModifierConfig) model.ModifierConfig {
+	merged := base
+	from := reflect.ValueOf(override)
+	to := reflect.ValueOf(&merged).Elem()
+	for i := 0; i < from.NumField(); i++ {
+		if field := from.Field(i); !field.IsNil() {
+			to.Field(i).Set(field)
+		}
+	}
+	return merged
+}
+
+// Modifiers folds several modifiers into one, later modifiers winning.
+func Modifiers(modifiers ...model.ModifierConfig) model.ModifierConfig {
+	var merged model.ModifierConfig
+	for _, modifier := range modifiers {
+		merged = MergeModifiers(merged, modifier)
+	}
+	return merged
+}
+
+func (mb *ModifierBuilder) Then(modifier model.ModifierConfig) *ModifierBuilder {
+	mb.modifier = MergeModifiers(mb.modifier, modifier)
+	return mb
+}
@@ -1,13 +1,17 @@
 package ui
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tokens"
+	"github.com/nicholaspark09/ssr-go/tree"
 )
 
 type ScreenBuilder struct {
 	screen model.ComponentScreen
+	tokens *tokens.Set
 }
 
 func NewScreen(id, title string, version string) *ScreenBuilder {
@@ -32,25 +36,66 @@ func (sb *ScreenBuilder) WithTheme(theme model.ThemeConfig) *ScreenBuilder {
 	return sb
 }
 
+func (sb *ScreenBuilder) WithTypography(typography model.Typography) *ScreenBuilder {
+	if sb.screen.Theme == nil {
+		sb.screen.Theme = &model.ThemeConfig{}
+	}
+	sb.screen.Theme.Typography = typography
+	return sb
+}
+
 func (sb *ScreenBuilder) WithData(data model.DataConfig) *ScreenBuilder {
 	sb.screen.Data = &data
 	return sb
 }
 
+// WithResolvedTokens makes ToJSON and ToPrettyJSON replace design token
+// references such as "{color.brand.primary}" with their values. Without it
+// the references are emitted as-is for the client to resolve.
+func (sb *ScreenBuilder) WithResolvedTokens(set *tokens.Set) *ScreenBuilder {
+	sb.tokens = set
+	return sb
+}
+
 func (sb *ScreenBuilder) ToJSON() (string, error) {
-	bytes, err := json.Marshal(sb.screen)
+	data, err := sb.marshal()
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal screen to JSON: %w", err)
+		return "", err
 	}
-	return string(bytes), nil
+	return string(data), nil
 }
 
 func (sb *ScreenBuilder) ToPrettyJSON() (string, error) {
-	bytes, err := json.MarshalIndent(sb.screen, "", "  ")
+	data, err := sb.marshal()
 	if err != nil {
+		return "", err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
 		return "", fmt.Errorf("failed to marshal screen to JSON: %w", err)
 	}
-	return string(bytes), nil
+	return indented.String(), nil
+}
+
+func (sb *ScreenBuilder) marshal() ([]byte, error) {
+	screen := sb.screen
+	screen.Screen.Layout = DeduplicateItemTemplates(screen.Screen.Layout)
+	data, err := json.Marshal(screen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal screen to JSON: %w", err)
+	}
+	if sb.tokens != nil {
+		if data, err = sb.tokens.ResolveJSON(data); err != nil {
+			return nil, fmt.Errorf("failed to resolve design tokens: %w", err)
+		}
+	}
+	return data, nil
+}
+
+// Query returns the layout nodes matching a CSS-like selector such as
+// "card > text[style=headline2]" or "#submit_button". See tree.Selector.
+func (sb *ScreenBuilder) Query(selector string) ([]tree.Match, error) {
+	return tree.QueryScreen(&sb.screen, selector)
 }
 
 func (sb *ScreenBuilder) Build() model.ComponentScreen {
@@ -72,3 +117,7 @@ func IntPtr(i int) *int {
 func Float32Ptr(f float32) *float32 {
 	return &f
 }
+
+func NodePtr(node model.ComponentNode) *model.ComponentNode {
+	return &node
+}
@@ -2,7 +2,10 @@ package ui
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tokens"
+	"github.com/nicholaspark09/ssr-go/tree"
 	"github.com/nicholaspark09/ssr-go/utils"
 	"strings"
 	"testing"
@@ -93,11 +96,25 @@ func TestBasicComponents(t *testing.T) {
 	}
 }
 
+// queryNodes returns the nodes under root matching selector.
+func queryNodes(t *testing.T, root *model.ComponentNode, selector string) []*model.ComponentNode {
+	t.Helper()
+	matches, err := tree.Query(root, "root", selector)
+	if err != nil {
+		t.Fatalf("Query(%q) failed: %v", selector, err)
+	}
+	nodes := make([]*model.ComponentNode, len(matches))
+	for i, match := range matches {
+		nodes[i] = match.Node
+	}
+	return nodes
+}
+
 func TestLayoutComponents(t *testing.T) {
 	tests := []struct {
 		name      string
 		component model.ComponentNode
-		validate  func(t *testing.T, json string)
+		validate  func(t *testing.T, root *model.ComponentNode)
 	}{
 		{
 			name: "Column with Children",
@@ -106,12 +123,12 @@ func TestLayoutComponents(t *testing.T) {
 				Text("Second item"),
 				Button("Action", NavigationAction("next")),
 			),
-			validate: func(t *testing.T, json string) {
-				if !strings.Contains(json, `"type":"column"`) {
-					t.Error("Missing column type")
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				if got := len(queryNodes(t, root, "column > text")); got != 2 {
+					t.Errorf("Expected 2 text children, got %d", got)
 				}
-				if !strings.Contains(json, `"children"`) {
-					t.Error("Missing children array")
+				if got := len(queryNodes(t, root, "column > button[text=Action]")); got != 1 {
+					t.Errorf("Expected 1 button child, got %d", got)
 				}
 			},
 		},
@@ -129,12 +146,12 @@ func TestLayoutComponents(t *testing.T) {
 						Build(),
 				).
 				Build(),
-			validate: func(t *testing.T, json string) {
-				if !strings.Contains(json, `"weight":1`) {
-					t.Error("Missing weight property")
-				}
-				if !strings.Contains(json, `"weight":2`) {
-					t.Error("Missing second weight property")
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				for text, weight := range map[string]float32{"Left": 1, "Right": 2} {
+					nodes := queryNodes(t, root, "row > text[text="+text+"]")
+					if len(nodes) != 1 || nodes[0].Modifier == nil || nodes[0].Modifier.Weight == nil || *nodes[0].Modifier.Weight != weight {
+						t.Errorf("Expected %s to have weight %g, got %v", text, weight, nodes)
+					}
 				}
 			},
 		},
@@ -144,12 +161,72 @@ func TestLayoutComponents(t *testing.T) {
 				Text("Card content"),
 				Spacer(16),
 			),
-			validate: func(t *testing.T, json string) {
-				if !strings.Contains(json, `"type":"card"`) {
-					t.Error("Missing card type")
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				if got := len(queryNodes(t, root, "card[elevation=4] > spacer")); got != 1 {
+					t.Errorf("Expected a card with elevation 4 holding a spacer, got %d matches", got)
 				}
-				if !strings.Contains(json, `"elevation":4`) {
-					t.Error("Missing elevation property")
+			},
+		},
+		{
+			name: "Arranged and Aligned Row",
+			component: Row(
+				Text("Left"),
+				Text("Right"),
+			).Arranged(model.SpaceBetween).WithChildAlignment(model.AlignCenterVertically),
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				rows := queryNodes(t, root, "row")
+				if len(rows) != 1 || rows[0].Arrangement == nil || *rows[0].Arrangement != model.SpaceBetween {
+					t.Error("Missing arrangement")
+				}
+				if len(rows) != 1 || rows[0].Alignment == nil || *rows[0].Alignment != model.AlignCenterVertically {
+					t.Error("Missing cross-axis alignment")
+				}
+			},
+		},
+		{
+			name:      "Column Spaced By",
+			component: Column(Text("A"), Text("B")).Arranged(SpacedBy(8)),
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				data, _ := json.Marshal(root)
+				if !strings.Contains(string(data), `"arrangement":"spacedBy(8dp)"`) {
+					t.Error("Missing spacedBy arrangement")
+				}
+			},
+		},
+		{
+			name: "Box with Aligned Badge",
+			component: AlignedBox(model.AlignCenter,
+				Image("https://example.com/avatar.jpg"),
+				Aligned(Text("3"), model.AlignTopEnd),
+			),
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				if boxes := queryNodes(t, root, "box[contentAlignment=center]"); len(boxes) != 1 {
+					t.Error("Missing content alignment")
+				}
+				badges := queryNodes(t, root, "box > text[text=3]")
+				if len(badges) != 1 || badges[0].Modifier == nil || badges[0].Modifier.Alignment == nil || *badges[0].Modifier.Alignment != model.AlignTopEnd {
+					t.Error("Missing child alignment")
+				}
+			},
+		},
+		{
+			name: "Stack with Gradient Scrim",
+			component: Stack(
+				Image("https://example.com/hero.jpg"),
+				NewComponent("box").
+					WithModifier(NewModifier().
+						FillMaxSize().
+						Gradient(LinearGradient(180, Stops("#00000000", "#000000CC")...)).
+						Build()).
+					Build(),
+				ZIndexed(Aligned(StyledText("Hero", "headline4"), model.AlignBottomStart), 10),
+			),
+			validate: func(t *testing.T, root *model.ComponentNode) {
+				for selector, zIndex := range map[string]float32{"stack > box": 1, "stack > text[style=headline4]": 10} {
+					nodes := queryNodes(t, root, selector)
+					if len(nodes) != 1 || nodes[0].Modifier == nil || nodes[0].Modifier.ZIndex == nil || *nodes[0].Modifier.ZIndex != zIndex {
+						t.Errorf("Expected %s to have z-index %g", selector, zIndex)
+					}
 				}
 			},
 		},
@@ -161,12 +238,10 @@ func TestLayoutComponents(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to marshal component: %v", err)
 			}
-
-			jsonStr := string(bytes)
-			utils.PrettyPrintJSON(t, jsonStr, tt.name)
+			utils.PrettyPrintJSON(t, string(bytes), tt.name)
 
 			if tt.validate != nil {
-				tt.validate(t, jsonStr)
+				tt.validate(t, &tt.component)
 			}
 		})
 	}
@@ -212,25 +287,27 @@ func TestEnhancedLazyColumn(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to marshal enhanced lazy column: %v", err)
 	}
-
-	jsonStr := string(bytes)
-	utils.PrettyPrintJSON(t, jsonStr, "Enhanced Lazy Column")
+	utils.PrettyPrintJSON(t, string(bytes), "Enhanced Lazy Column")
 
 	// Validate structure
-	if !strings.Contains(jsonStr, `"type":"enhanced_lazy_column"`) {
-		t.Error("Missing enhanced_lazy_column type")
+	lists := queryNodes(t, &component, "enhanced_lazy_column")
+	if len(lists) != 1 || lists[0].DataSource == nil || lists[0].ItemTemplate == nil {
+		t.Fatal("Expected an enhanced_lazy_column with a dataSource and an itemTemplate")
 	}
-	if !strings.Contains(jsonStr, `"dataSource"`) {
-		t.Error("Missing dataSource")
+	if got := len(queryNodes(t, &component, `enhanced_lazy_column text[text="{{title}}"]`)); got != 2 {
+		t.Errorf("Expected the item template layout and the embedded title, got %d matches", got)
 	}
-	if !strings.Contains(jsonStr, `"itemTemplate"`) {
-		t.Error("Missing itemTemplate")
+	if got := len(queryNodes(t, &component, "enhanced_lazy_column > card > text")); got != 2 {
+		t.Errorf("Expected the embedded card template with 2 texts, got %d matches", got)
 	}
-	if !strings.Contains(jsonStr, `"component_type":"chart_bar"`) {
-		t.Error("Missing chart_bar component type")
+	var componentTypes []interface{}
+	for _, item := range lists[0].DataSource.Items {
+		componentTypes = append(componentTypes, item["component_type"])
 	}
-	if !strings.Contains(jsonStr, `"component_type":"spacer"`) {
-		t.Error("Missing spacer component type")
+	for _, want := range []string{"chart_bar", "spacer", "custom_type"} {
+		if !strings.Contains(fmt.Sprint(componentTypes), want) {
+			t.Errorf("Missing %s component type in %v", want, componentTypes)
+		}
 	}
 }
 
@@ -249,7 +326,7 @@ func TestCompleteScreen(t *testing.T) {
 				NewComponent("scrollable_column").
 					WithModifier(model.ModifierConfig{
 						FillMaxSize: BoolPtr(true),
-						Padding:     IntPtr(16),
+						Padding:     DpPtr(16),
 					}).
 					WithChildren(
 						StyledText("Analytics Overview", "headline2"),
@@ -297,4 +374,134 @@ func TestCompleteScreen(t *testing.T) {
 	if !strings.Contains(jsonStr, `"layout"`) {
 		t.Error("Missing layout")
 	}
+
+	for _, tt := range []struct {
+		selector string
+		count    int
+	}{
+		{"column > top_app_bar", 1},
+		{"scrollable_column > text[style=headline2]", 1},
+		{"scrollable_column > card > bar_chart", 1},
+		{"row > card", 2},
+		{"row card > text[text$=panel]", 2},
+	} {
+		matches, err := screen.Query(tt.selector)
+		if err != nil {
+			t.Fatalf("Query(%q) failed: %v", tt.selector, err)
+		}
+		if len(matches) != tt.count {
+			t.Errorf("Query(%q) matched %d nodes, want %d", tt.selector, len(matches), tt.count)
+		}
+	}
+}
+
+func TestItemTemplateDeduplication(t *testing.T) {
+	promoCard := Card(
+		StyledText("{{title}}", model.Headline6),
+		Text("{{subtitle}}"),
+	)
+
+	items := []map[string]interface{}{
+		ItemWithTemplate(map[string]interface{}{"title": "Sale", "subtitle": "50% off"}, promoCard),
+		ItemWithComponentType(map[string]interface{}{"name": "Alice"}, "contact"),
+		ItemWithTemplate(map[string]interface{}{"title": "New", "subtitle": "Just landed"}, promoCard),
+		SpacerItem(24),
+	}
+
+	list := NewComponent("enhanced_lazy_column").
+		WithDataSource(EnhancedStaticDataSource(items)).
+		WithItemTemplate(MultiItemTemplate("default", Text("{{title}}"), map[string]model.ComponentNode{
+			"contact": Row(CircleImage("{{avatar}}", 40), Text("{{name}}")),
+		})).
+		Build()
+
+	jsonStr, err := NewScreen("feed", "Feed", "1.0").WithLayout(list).ToJSON()
+	if err != nil {
+		t.Fatalf("Failed to generate screen JSON: %v", err)
+	}
+	utils.PrettyPrintJSON(t, jsonStr, "Deduplicated Templates")
+
+	if strings.Contains(jsonStr, `"template":`) {
+		t.Error("Embedded item templates should be moved into the templates map")
+	}
+	if count := strings.Count(jsonStr, `"text":"{{subtitle}}"`); count != 1 {
+		t.Errorf("Expected the promo layout once, found it %d times", count)
+	}
+	if !strings.Contains(jsonStr, `"contact":{"type":"row"`) {
+		t.Error("Declared templates should be kept")
+	}
+
+	if _, ok := list.DataSource.Items[0]["template"]; !ok {
+		t.Error("Encoding must not modify the builder's items")
+	}
+
+	deduplicated := DeduplicateItemTemplates(list)
+	first := deduplicated.DataSource.Items[0]["component_type"]
+	third := deduplicated.DataSource.Items[2]["component_type"]
+	if first == nil || first != third {
+		t.Errorf("Identical templates should share a name, got %v and %v", first, third)
+	}
+	if len(deduplicated.ItemTemplate.Templates) != 2 {
+		t.Errorf("Expected contact plus one extracted template, got %d", len(deduplicated.ItemTemplate.Templates))
+	}
+}
+
+func TestItemTemplateDeduplicationWithoutItemTemplate(t *testing.T) {
+	typed := ItemWithTemplate(map[string]interface{}{"title": "Pinned"}, Text("{{title}}"))
+	typed["component_type"] = "pinned"
+	list := NewComponent("lazy_column").
+		WithDataSource(EnhancedStaticDataSource([]map[string]interface{}{
+			ItemWithTemplate(map[string]interface{}{"title": "Sale"}, Card(Text("{{title}}!"))),
+			typed,
+		})).
+		Build()
+
+	jsonStr, err := NewScreen("feed", "Feed", "1.0").WithLayout(list).ToJSON()
+	if err != nil {
+		t.Fatalf("Failed to generate screen JSON: %v", err)
+	}
+	if count := strings.Count(jsonStr, `"text":"{{title}}!"`); count != 1 {
+		t.Errorf("Expected the extracted layout once, found it %d times: %s", count, jsonStr)
+	}
+	if strings.Contains(jsonStr, `"type":""`) || strings.Contains(jsonStr, `"default":`) {
+		t.Errorf("Extraction should not invent a fallback layout: %s", jsonStr)
+	}
+
+	deduplicated := DeduplicateItemTemplates(list)
+	pinned := deduplicated.DataSource.Items[1]
+	if pinned["component_type"] != "pinned" || pinned["template"] == nil {
+		t.Errorf("Items with a component_type should be left alone, got %v", pinned)
+	}
+
+	built := NewComponent("lazy_column").WithItemTemplateFor("contact", Text("{{name}}")).Build()
+	if built.ItemTemplate.Default != "contact" || built.ItemTemplate.Layout.Type != "" {
+		t.Errorf("Expected the first registered template to be the default by name, got %+v", built.ItemTemplate)
+	}
+}
+
+func TestResolvedTokensKeepLiteralBraces(t *testing.T) {
+	set, err := tokens.Parse([]byte(`{"color":{"brand":{"$type":"color","$value":"#3B82F6"}}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse tokens: %v", err)
+	}
+
+	jsonStr, err := NewScreen("users", "Users", "1.0").
+		WithLayout(Column(
+			NewComponent("text").
+				WithProperty("text", "/users/{id}").
+				WithProperty("color", tokens.Ref("color.brand")).
+				Build(),
+			TextField("zip", "ZIP").WithRules(Pattern(`\\d{3}`, "Three digits")).Build(),
+		)).
+		WithResolvedTokens(set).
+		ToJSON()
+	if err != nil {
+		t.Fatalf("Failed to generate screen JSON: %v", err)
+	}
+	if !strings.Contains(jsonStr, `"text":"/users/{id}"`) || !strings.Contains(jsonStr, `"value":"\\\\d{3}"`) {
+		t.Errorf("Literal braces should be left alone: %s", jsonStr)
+	}
+	if !strings.Contains(jsonStr, `"#3B82F6"`) {
+		t.Errorf("Token reference was not resolved: %s", jsonStr)
+	}
 }
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+// SectionKeyPlaceholder is bound to the group value when a section header
+// template is rendered.
+const SectionKeyPlaceholder = "{{section}}"
+
+func Sections(groupBy string, headerTemplate model.ComponentNode) model.SectionConfig {
+	return model.SectionConfig{
+		GroupBy:        groupBy,
+		HeaderTemplate: headerTemplate,
+	}
+}
+
+func StickySections(groupBy string, headerTemplate model.ComponentNode) model.SectionConfig {
+	sections := Sections(groupBy, headerTemplate)
+	sections.StickyHeaders = true
+	return sections
+}
+
+func (cb *ComponentBuilder) WithSections(sections model.SectionConfig) *ComponentBuilder {
+	cb.component.Sections = &sections
+	return cb
+}
+
+func SectionedLazyColumn(dataSource model.DataSource, sections model.SectionConfig, itemTemplate model.ItemTemplate) model.ComponentNode {
+	return NewComponent("sectioned_lazy_column").
+		WithDataSource(dataSource).
+		WithSections(sections).
+		WithItemTemplate(itemTemplate).
+		withDefaultSkeleton().
+		Build()
+}
+
+// SectionedStaticDataSource groups items by key so each section is contiguous.
+func SectionedStaticDataSource(items []map[string]interface{}, key string) model.DataSource {
+	return StaticDataSource(GroupItems(items, key))
+}
+
+// GroupItems reorders items so that items sharing a value for key are
+// contiguous. Sections appear in the order their first item did and items
+// keep their relative order within a section.
+func GroupItems(items []map[string]interface{}, key string) []map[string]interface{} {
+	var order []string
+	groups := make(map[string][]map[string]interface{})
+	for _, item := range items {
+		group := sectionKey(item, key)
+		if _, seen := groups[group]; !seen {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], item)
+	}
+
+	grouped := make([]map[string]interface{}, 0, len(items))
+	for _, group := range order {
+		grouped = append(grouped, groups[group]...)
+	}
+	return grouped
+}
+
+// PaginateSections slices an already grouped item list into the page the
+// client asked for and records whether the page continues the section the
+// previous page ended with.
+func PaginateSections(items []map[string]interface{}, key string, offset, limit int) model.SectionPage {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + limit
+	if limit <= 0 || end > len(items) {
+		end = len(items)
+	}
+
+	page := model.SectionPage{
+		Items:   items[offset:end],
+		HasMore: end < len(items),
+	}
+	if offset > 0 && offset < end {
+		page.ContinuesPreviousSection = sectionKey(items[offset-1], key) == sectionKey(items[offset], key)
+	}
+	if end > offset {
+		page.LastSection = items[end-1][key]
+	}
+	return page
+}
+
+func sectionKey(item map[string]interface{}, key string) string {
+	value, ok := item[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"strings"
+	"testing"
+)
+
+func settingsItems() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"group": "Account", "title": "Profile"},
+		{"group": "Privacy", "title": "Blocked users"},
+		{"group": "Account", "title": "Password"},
+		{"group": "Notifications", "title": "Email"},
+		{"group": "Privacy", "title": "Location"},
+	}
+}
+
+func TestGroupItems(t *testing.T) {
+	grouped := GroupItems(settingsItems(), "group")
+
+	var titles []string
+	for _, item := range grouped {
+		titles = append(titles, item["title"].(string))
+	}
+	want := "Profile,Password,Blocked users,Location,Email"
+	if got := strings.Join(titles, ","); got != want {
+		t.Errorf("GroupItems order = %s, want %s", got, want)
+	}
+}
+
+func TestPaginateSections(t *testing.T) {
+	grouped := GroupItems(settingsItems(), "group")
+
+	tests := []struct {
+		name         string
+		offset       int
+		limit        int
+		wantItems    int
+		wantContinue bool
+		wantMore     bool
+		wantLast     string
+	}{
+		{"First page", 0, 3, 3, false, true, "Privacy"},
+		{"Page splitting a section", 3, 2, 2, true, false, "Notifications"},
+		{"Page starting a section", 2, 3, 3, false, false, "Notifications"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := PaginateSections(grouped, "group", tt.offset, tt.limit)
+			if len(page.Items) != tt.wantItems {
+				t.Errorf("Expected %d items, got %d", tt.wantItems, len(page.Items))
+			}
+			if page.ContinuesPreviousSection != tt.wantContinue {
+				t.Errorf("Expected continuesPreviousSection=%v", tt.wantContinue)
+			}
+			if page.HasMore != tt.wantMore {
+				t.Errorf("Expected hasMore=%v", tt.wantMore)
+			}
+			if page.LastSection != tt.wantLast {
+				t.Errorf("Expected lastSection %q, got %v", tt.wantLast, page.LastSection)
+			}
+		})
+	}
+}
+
+func TestSectionedLazyColumn(t *testing.T) {
+	component := SectionedLazyColumn(
+		SectionedStaticDataSource(settingsItems(), "group"),
+		StickySections("group", StyledText(SectionKeyPlaceholder, model.Overline)),
+		model.ItemTemplate{Type: "setting", Layout: Text("{{title}}")},
+	)
+
+	bytes, err := json.Marshal(component)
+	if err != nil {
+		t.Fatalf("Failed to marshal sectioned list: %v", err)
+	}
+
+	jsonStr := string(bytes)
+	utils.PrettyPrintJSON(t, jsonStr, "Sectioned Lazy Column")
+
+	if !strings.Contains(jsonStr, `"type":"sectioned_lazy_column"`) {
+		t.Error("Missing sectioned_lazy_column type")
+	}
+	if !strings.Contains(jsonStr, `"groupBy":"group"`) || !strings.Contains(jsonStr, `"stickyHeaders":true`) {
+		t.Error("Missing section configuration")
+	}
+	if !strings.Contains(jsonStr, `"text":"{{section}}"`) {
+		t.Error("Missing header template bound to the section key")
+	}
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+)
+
+const defaultSkeletonCount = 3
+
+var skeletonContainers = map[string]bool{
+	"column":            true,
+	"scrollable_column": true,
+	"row":               true,
+	"card":              true,
+	"box":               true,
+	"stack":             true,
+}
+
+// Skeleton derives a placeholder tree from a layout: containers keep their
+// structure and modifiers, text becomes a shimmer bar carrying the same style
+// so it has the same height, and every other leaf becomes a placeholder box
+// with the original size and shape, whether set by its modifier or its
+// modifier chain. Actions are dropped.
+func Skeleton(layout model.ComponentNode) model.ComponentNode {
+	switch {
+	case layout.Type == "spacer":
+		return layout
+	case layout.Type == "text":
+		bar := skeletonLeaf("shimmer_bar", layout)
+		if style, ok := layout.Properties["style"]; ok {
+			bar.WithProperty("style", style)
+		}
+		return bar.Build()
+	case skeletonContainers[layout.Type]:
+		skeleton := layout
+		skeleton.ID = nil
+		skeleton.Actions = nil
+		skeleton.Properties = copySkeletonProperties(layout.Properties, "elevation", "contentAlignment")
+		skeleton.Children = make([]model.ComponentNode, len(layout.Children))
+		for i, child := range layout.Children {
+			skeleton.Children[i] = Skeleton(child)
+		}
+		return skeleton
+	default:
+		box := skeletonLeaf("placeholder_box", layout)
+		for key, value := range copySkeletonProperties(layout.Properties, "size", "shape", "height", "width") {
+			box.WithProperty(key, value)
+		}
+		return box.Build()
+	}
+}
+
+// SkeletonLoadingFor derives a skeleton from the layout most items are
+// expected to use, as a loading state has a single layout: the template's
+// Layout, else its Default template, else its first template by name.
+// Templates without any layout get a shimmer instead.
+func SkeletonLoadingFor(template model.ItemTemplate, count int) model.LoadingState {
+	source, ok := skeletonSource(template)
+	if !ok {
+		return ShimmerLoading(count)
+	}
+	layout := Skeleton(source)
+	return model.LoadingState{Type: "skeleton", Count: &count, Layout: &layout}
+}
+
+func skeletonSource(template model.ItemTemplate) (model.ComponentNode, bool) {
+	if template.Layout.Type != "" {
+		return template.Layout, true
+	}
+	if layout, ok := template.Templates[template.Default]; ok {
+		return layout, true
+	}
+	for _, name := range utils.SortedKeys(template.Templates) {
+		return template.Templates[name], true
+	}
+	return model.ComponentNode{}, false
+}
+
+// WithSkeletonLoading derives the loading template from the builder's item
+// template, so WithItemTemplate must be called first.
+func (cb *ComponentBuilder) WithSkeletonLoading(count int) *ComponentBuilder {
+	if cb.component.ItemTemplate == nil {
+		return cb
+	}
+	return cb.WithLoadingTemplate(SkeletonLoadingFor(*cb.component.ItemTemplate, count))
+}
+
+// withDefaultSkeleton gives API-backed lists a skeleton unless the caller
+// already chose a loading state. Static lists never load.
+func (cb *ComponentBuilder) withDefaultSkeleton() *ComponentBuilder {
+	dataSource := cb.component.DataSource
+	if dataSource == nil || dataSource.Type != "api" || cb.component.LoadingTemplate != nil {
+		return cb
+	}
+	return cb.WithSkeletonLoading(defaultSkeletonCount)
+}
+
+// skeletonLeaf starts a placeholder that keeps the size set by the node's
+// modifier or modifier chain.
+func skeletonLeaf(placeholderType string, node model.ComponentNode) *ComponentBuilder {
+	leaf := NewComponent(placeholderType)
+	if node.Modifier != nil {
+		leaf.WithModifier(*node.Modifier)
+	}
+	if len(node.ModifierChain) > 0 {
+		leaf.WithModifierChain(node.ModifierChain...)
+	}
+	return leaf
+}
+
+func copySkeletonProperties(properties map[string]interface{}, keys ...string) map[string]interface{} {
+	copied := make(map[string]interface{})
+	for _, key := range keys {
+		if value, ok := properties[key]; ok {
+			copied[key] = value
+		}
+	}
+	return copied
+}
@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"encoding/json"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"strings"
+	"testing"
+)
+
+func TestSkeletonFromTemplate(t *testing.T) {
+	template := model.ItemTemplate{
+		Type: "contact",
+		Layout: NewComponent("row").
+			WithModifier(PaddingModifier(16)).
+			WithChildren(
+				CircleImage("{{avatarUrl}}", 48),
+				Column(
+					StyledText("{{name}}", model.Subtitle1),
+					StyledText("{{email}}", model.Body2),
+				),
+				Button("Call", NavigationAction("call")),
+			).
+			Build(),
+	}
+
+	list := LazyColumn(APIDataSource("https://api.example.com/contacts", "GET"), template)
+	if list.LoadingTemplate == nil || list.LoadingTemplate.Layout == nil {
+		t.Fatal("Expected API-backed LazyColumn to get a skeleton loading template")
+	}
+
+	bytes, err := json.Marshal(list.LoadingTemplate)
+	if err != nil {
+		t.Fatalf("Failed to marshal loading template: %v", err)
+	}
+
+	jsonStr := string(bytes)
+	utils.PrettyPrintJSON(t, jsonStr, "Skeleton Loading")
+
+	if !strings.Contains(jsonStr, `"type":"skeleton","count":3`) {
+		t.Error("Missing skeleton type and default count")
+	}
+	if !strings.Contains(jsonStr, `"type":"row"`) || !strings.Contains(jsonStr, `"padding":"16dp"`) {
+		t.Error("Expected containers and their modifiers to be preserved")
+	}
+	if !strings.Contains(jsonStr, `"shape":"circle","size":48`) {
+		t.Error("Expected the image placeholder to keep its shape and size")
+	}
+	if strings.Count(jsonStr, `"type":"shimmer_bar"`) != 2 || !strings.Contains(jsonStr, `"style":"subtitle1"`) {
+		t.Error("Expected text to become shimmer bars with matching styles")
+	}
+	if strings.Contains(jsonStr, "{{") || strings.Contains(jsonStr, "onClick") {
+		t.Error("Skeleton should not carry data placeholders or actions")
+	}
+
+	static := LazyColumn(StaticDataSource(nil), template)
+	if static.LoadingTemplate != nil {
+		t.Error("Static lists should not get a loading template")
+	}
+}
+
+func TestSkeletonKeepsModifierChainAndTemplates(t *testing.T) {
+	thumbnail := NewComponent("image").
+		WithProperty("url", "{{thumbnail}}").
+		WithModifierChain(NewModifier().Size(64, 64).Build(), NewModifier().Clip(RoundedCornerShape(8)).Build()).
+		Build()
+	placeholder := Skeleton(thumbnail)
+	if len(placeholder.ModifierChain) != 2 || *placeholder.ModifierChain[0].Width != Dp(64) || placeholder.Modifier != nil {
+		t.Errorf("Expected the placeholder to keep the modifier chain, got %+v", placeholder)
+	}
+
+	list := NewComponent("lazy_column").
+		WithDataSource(APIDataSource("/api/feed", "GET")).
+		WithItemTemplateFor("video", Card(thumbnail)).
+		WithItemTemplateFor("post", Row(Text("{{title}}"))).
+		WithSkeletonLoading(2).
+		Build()
+	if list.LoadingTemplate == nil || list.LoadingTemplate.Layout == nil || list.LoadingTemplate.Layout.Type != "card" {
+		t.Errorf("Expected the skeleton to follow the default video template, got %+v", list.LoadingTemplate)
+	}
+
+	empty := SkeletonLoadingFor(model.ItemTemplate{Type: "default"}, 2)
+	if empty.Type != "shimmer" || empty.Layout != nil {
+		t.Errorf("Expected a shimmer for a template without layouts, got %+v", empty)
+	}
+}
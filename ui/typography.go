@@ -0,0 +1,41 @@
+package ui
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+func TextStyle(fontFamily string, fontSize float32, fontWeight int) model.TextStyle {
+	return model.TextStyle{
+		FontFamily: fontFamily,
+		FontSize:   fontSize,
+		FontWeight: fontWeight,
+	}
+}
+
+func TextStyleWithSpacing(fontFamily string, fontSize float32, fontWeight int, lineHeight, letterSpacing float32) model.TextStyle {
+	return model.TextStyle{
+		FontFamily:    fontFamily,
+		FontSize:      fontSize,
+		FontWeight:    fontWeight,
+		LineHeight:    &lineHeight,
+		LetterSpacing: &letterSpacing,
+	}
+}
+
+// DefaultTypography mirrors the Material type scale so screens that only use
+// the stock style names validate without declaring a scale of their own.
+func DefaultTypography(fontFamily string) model.Typography {
+	return model.Typography{
+		model.Headline1:  TextStyleWithSpacing(fontFamily, 96, 300, 112, -1.5),
+		model.Headline2:  TextStyleWithSpacing(fontFamily, 60, 300, 72, -0.5),
+		model.Headline3:  TextStyleWithSpacing(fontFamily, 48, 400, 56, 0),
+		model.Headline4:  TextStyleWithSpacing(fontFamily, 34, 400, 36, 0.25),
+		model.Headline5:  TextStyleWithSpacing(fontFamily, 24, 400, 24, 0),
+		model.Headline6:  TextStyleWithSpacing(fontFamily, 20, 500, 24, 0.15),
+		model.Subtitle1:  TextStyleWithSpacing(fontFamily, 16, 400, 24, 0.15),
+		model.Subtitle2:  TextStyleWithSpacing(fontFamily, 14, 500, 24, 0.1),
+		model.Body1:      TextStyleWithSpacing(fontFamily, 16, 400, 24, 0.5),
+		model.Body2:      TextStyleWithSpacing(fontFamily, 14, 400, 20, 0.25),
+		model.ButtonText: TextStyleWithSpacing(fontFamily, 14, 500, 16, 1.25),
+		model.Caption:    TextStyleWithSpacing(fontFamily, 12, 400, 16, 0.4),
+		model.Overline:   TextStyleWithSpacing(fontFamily, 10, 400, 16, 1.5),
+	}
+}
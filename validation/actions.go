@@ -0,0 +1,223 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/form"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/utils"
+	"regexp"
+	"strings"
+)
+
+var httpMethods = map[string]bool{
+	"GET":    true,
+	"POST":   true,
+	"PUT":    true,
+	"PATCH":  true,
+	"DELETE": true,
+}
+
+// actionContext carries what action checks need to know about the screen
+// as a whole.
+type actionContext struct {
+	fieldNames   map[string]int // number of fields per name
+	apiEndpoints map[string]string
+}
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+func checkActions(screen model.ComponentScreen) []Issue {
+	ctx := actionContext{fieldNames: make(map[string]int)}
+	collectFieldNames(screen.Screen.Layout, ctx.fieldNames)
+	if screen.Data != nil {
+		ctx.apiEndpoints = screen.Data.APIEndpoints
+	}
+
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		for _, name := range utils.SortedKeys(node.Actions) {
+			issues = append(issues, checkAction(node.Actions[name], path+".actions."+name, ctx)...)
+		}
+		if node.ItemTemplate != nil {
+			for _, name := range utils.SortedKeys(node.ItemTemplate.Actions) {
+				issues = append(issues, checkAction(node.ItemTemplate.Actions[name], path+".itemTemplate.actions."+name, ctx)...)
+			}
+		}
+	})
+	return issues
+}
+
+func checkAction(action model.ActionConfig, path string, ctx actionContext) []Issue {
+	var issues []Issue
+	switch action.Type {
+	case "api_call":
+		issues = append(issues, checkEndpoint(action, path, ctx)...)
+		if action.Method == nil {
+			issues = append(issues, errorf(path, "api_call needs a method"))
+		} else if !httpMethods[strings.ToUpper(*action.Method)] {
+			issues = append(issues, errorf(path, "unknown HTTP method %q", *action.Method))
+		} else if len(action.Body) > 0 && (strings.EqualFold(*action.Method, "GET") || strings.EqualFold(*action.Method, "DELETE")) {
+			issues = append(issues, warnf(path, "%s requests usually ignore a body", strings.ToUpper(*action.Method)))
+		}
+		if !inItemScope(path) {
+			for _, placeholder := range bodyPlaceholders(action.Body) {
+				issues = append(issues, warnf(path+".body", "placeholder {{%s}} has no item data to bind to outside an item template", placeholder))
+			}
+		}
+		if action.OnError == nil && action.Optimistic != nil {
+			issues = append(issues, warnf(path, "optimistic api_call has no onError action to tell the user it was reverted"))
+		}
+	case "submit_form":
+		issues = append(issues, checkEndpoint(action, path, ctx)...)
+		if action.Method != nil && !httpMethods[strings.ToUpper(*action.Method)] {
+			issues = append(issues, errorf(path, "unknown HTTP method %q", *action.Method))
+		}
+		if len(action.Fields) == 0 {
+			issues = append(issues, errorf(path, "submit_form names no fields to collect"))
+		}
+		for _, field := range action.Fields {
+			switch count := ctx.fieldNames[field]; {
+			case count == 0:
+				issues = append(issues, errorf(path, "submit_form collects %q but the screen has no field with that name", field))
+			case count > 1:
+				issues = append(issues, errorf(path, "submit_form collects %q but %d fields on the screen have that name", field, count))
+			}
+		}
+	case "show_message":
+		if action.Message == nil || *action.Message == "" {
+			issues = append(issues, errorf(path, "show_message needs a message"))
+		}
+	case "analytics":
+		if action.Event == nil || *action.Event == "" {
+			issues = append(issues, errorf(path, "analytics needs an event name"))
+		}
+	case "sequence", "parallel":
+		if len(action.Actions) == 0 {
+			issues = append(issues, errorf(path, "%s has no actions", action.Type))
+		} else if len(action.Actions) == 1 {
+			issues = append(issues, warnf(path, "%s with a single action can be replaced by that action", action.Type))
+		}
+		for i, nested := range action.Actions {
+			issues = append(issues, checkAction(nested, fmt.Sprintf("%s.actions[%d]", path, i), ctx)...)
+		}
+	case "conditional":
+		if action.Condition == nil || *action.Condition == "" {
+			issues = append(issues, errorf(path, "conditional needs a condition"))
+		} else {
+			issues = append(issues, checkCondition(*action.Condition, path+".condition", ctx)...)
+		}
+		if action.Then == nil {
+			issues = append(issues, errorf(path, "conditional needs a then action"))
+		}
+	case "confirm":
+		if action.Confirm == nil || action.Confirm.Message == "" {
+			issues = append(issues, errorf(path, "confirm needs a dialog message"))
+		}
+		if action.Then == nil {
+			issues = append(issues, errorf(path, "confirm needs a then action to run once confirmed"))
+		}
+	case "navigation", "replace_screen":
+		if action.Destination == nil || *action.Destination == "" {
+			issues = append(issues, errorf(path, "%s needs a destination", action.Type))
+		}
+	}
+
+	if action.ActionID != nil {
+		if *action.ActionID == "" {
+			issues = append(issues, errorf(path, "actionId is empty"))
+		} else if action.Type != "api_call" {
+			issues = append(issues, errorf(path, "actionId %q is only dispatched for api_call, not %s", *action.ActionID, action.Type))
+		}
+	}
+
+	if action.OnSuccess != nil {
+		issues = append(issues, checkAction(*action.OnSuccess, path+".onSuccess", ctx)...)
+	}
+	if action.OnError != nil {
+		issues = append(issues, checkAction(*action.OnError, path+".onError", ctx)...)
+	}
+	if action.Then != nil {
+		issues = append(issues, checkAction(*action.Then, path+".then", ctx)...)
+	}
+	if action.Else != nil {
+		issues = append(issues, checkAction(*action.Else, path+".else", ctx)...)
+	}
+	return issues
+}
+
+func checkCondition(condition, path string, ctx actionContext) []Issue {
+	refs, err := parseCondition(condition)
+	if err != nil {
+		return []Issue{errorf(path, "invalid condition %q: %v", condition, err)}
+	}
+	var issues []Issue
+	for _, ref := range refs {
+		root, name, _ := strings.Cut(ref, ".")
+		switch {
+		case root == "item" && !inItemScope(path):
+			issues = append(issues, warnf(path, "%s has no item data to read outside an item template", ref))
+		case root == "form" && ctx.fieldNames[name] == 0:
+			issues = append(issues, errorf(path, "%s reads a field the screen does not have", ref))
+		}
+	}
+	return issues
+}
+
+func checkEndpoint(action model.ActionConfig, path string, ctx actionContext) []Issue {
+	hasEndpoint := action.Endpoint != nil && *action.Endpoint != ""
+	hasKey := action.EndpointKey != nil && *action.EndpointKey != ""
+	switch {
+	case hasEndpoint && hasKey:
+		return []Issue{errorf(path, "%s sets both endpoint and endpointKey", action.Type)}
+	case !hasEndpoint && !hasKey:
+		return []Issue{errorf(path, "%s needs an endpoint or endpointKey", action.Type)}
+	case hasKey:
+		if _, ok := ctx.apiEndpoints[*action.EndpointKey]; !ok {
+			return []Issue{errorf(path, "endpointKey %q is not in data.apiEndpoints", *action.EndpointKey)}
+		}
+	}
+	return nil
+}
+
+// inItemScope reports whether path is inside a template rendered once per
+// data source item or section, where placeholders can be bound.
+func inItemScope(path string) bool {
+	for _, scope := range []string{".itemTemplate", ".dataSource.items", ".sections.headerTemplate"} {
+		if strings.Contains(path, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func bodyPlaceholders(value interface{}) []string {
+	var placeholders []string
+	switch v := value.(type) {
+	case string:
+		for _, match := range placeholderPattern.FindAllStringSubmatch(v, -1) {
+			placeholders = append(placeholders, match[1])
+		}
+	case map[string]interface{}:
+		for _, key := range utils.SortedKeys(v) {
+			placeholders = append(placeholders, bodyPlaceholders(v[key])...)
+		}
+	case []interface{}:
+		for _, element := range v {
+			placeholders = append(placeholders, bodyPlaceholders(element)...)
+		}
+	}
+	return placeholders
+}
+
+// collectFieldNames only follows Children: fields inside item templates
+// repeat per item and are not part of the screen's form payload.
+func collectFieldNames(node model.ComponentNode, names map[string]int) {
+	if form.IsField(node) {
+		if name, ok := node.Properties["name"].(string); ok && name != "" {
+			names[name]++
+		}
+	}
+	for _, child := range node.Children {
+		collectFieldNames(child, names)
+	}
+}
@@ -0,0 +1,182 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/color"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tokens"
+)
+
+var colorProperties = []string{"color", "backgroundColor", "contentColor", "borderColor"}
+
+func checkColors(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	checkColor := func(path, value string) {
+		if value == "" || tokens.IsRef(value) {
+			return
+		}
+		if _, err := color.Parse(value); err != nil {
+			issues = append(issues, errorf(path, "%v", err))
+		}
+	}
+
+	if theme := screen.Theme; theme != nil {
+		checkColor("theme.primaryColor", theme.PrimaryColor)
+		checkColor("theme.secondaryColor", theme.SecondaryColor)
+		checkColor("theme.backgroundColor", theme.BackgroundColor)
+		checkColor("theme.textColor", theme.TextColor)
+		for name, gradient := range theme.Gradients {
+			for i, c := range gradient.Colors {
+				checkColor(fmt.Sprintf("theme.gradients.%s.colors[%d]", name, i), c)
+			}
+		}
+	}
+
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if modifier := node.Modifier; modifier != nil {
+			if modifier.Gradient != nil {
+				for i, c := range modifier.Gradient.Colors {
+					checkColor(fmt.Sprintf("%s.modifier.gradient.colors[%d]", path, i), c)
+				}
+			}
+			if modifier.Background != nil {
+				checkColor(path+".modifier.background", *modifier.Background)
+			}
+			if modifier.Border != nil {
+				checkColor(path+".modifier.border.color", modifier.Border.Color)
+			}
+			if modifier.Shadow != nil && modifier.Shadow.Color != nil {
+				checkColor(path+".modifier.shadow.color", *modifier.Shadow.Color)
+			}
+		}
+		for _, key := range colorProperties {
+			if value, ok := node.Properties[key].(string); ok {
+				checkColor(path+".properties."+key, value)
+			}
+		}
+		checkChartColors(node.Properties, path+".properties", checkColor)
+		if node.DataSource != nil {
+			for i, item := range node.DataSource.Items {
+				checkChartColors(item, fmt.Sprintf("%s.dataSource.items[%d]", path, i), checkColor)
+			}
+		}
+	})
+	return issues
+}
+
+func checkChartColors(values map[string]interface{}, path string, checkColor func(path, value string)) {
+	if points, ok := values["data"].([]model.ChartDataPoint); ok {
+		for i, point := range points {
+			if point.Color != nil {
+				checkColor(fmt.Sprintf("%s.data[%d].color", path, i), *point.Color)
+			}
+		}
+	}
+	if series, ok := values["series"].([]model.ChartSeries); ok {
+		for i, s := range series {
+			if s.Color != nil {
+				checkColor(fmt.Sprintf("%s.series[%d].color", path, i), *s.Color)
+			}
+			for j, point := range s.Data {
+				if point.Color != nil {
+					checkColor(fmt.Sprintf("%s.series[%d].data[%d].color", path, i, j), *point.Color)
+				}
+			}
+		}
+	}
+	if colors, ok := values["colors"].([]string); ok {
+		for i, c := range colors {
+			checkColor(fmt.Sprintf("%s.colors[%d]", path, i), c)
+		}
+	}
+}
+
+// checkContrast warns when text fails WCAG AA against the background it is
+// drawn on: the nearest ancestor with a background, or the theme background.
+func checkContrast(screen model.ComponentScreen) []Issue {
+	theme := screen.Theme
+	if theme == nil {
+		return nil
+	}
+	themeText, textErr := color.Parse(theme.TextColor)
+	themeBackground, backgroundErr := color.Parse(theme.BackgroundColor)
+	if textErr != nil || backgroundErr != nil {
+		return nil
+	}
+
+	var issues []Issue
+	if !color.PassesAA(themeText, themeBackground, false) {
+		issues = append(issues, warnf("theme.textColor",
+			"text color %s on background %s has contrast %.2f:1, below the WCAG AA minimum of %.1f:1",
+			theme.TextColor, theme.BackgroundColor,
+			color.ContrastRatio(themeText, themeBackground), color.MinContrastNormalText))
+	}
+
+	backgrounds := map[*model.ComponentNode]color.Color{}
+	walkScreen(screen, func(node, parent *model.ComponentNode, path string) {
+		background := themeBackground
+		if parent != nil {
+			background = backgrounds[parent]
+		}
+		if c, ok := nodeBackground(node); ok {
+			background = c.Over(background)
+		}
+		backgrounds[node] = background
+
+		if node.Type != "text" {
+			return
+		}
+		foreground, ok := nodeColor(node, "color")
+		if !ok {
+			// A color that does not parse, such as a token reference, is not
+			// the theme's, so there is nothing reliable to compare.
+			if _, set := node.Properties["color"]; set || background == themeBackground {
+				return
+			}
+			foreground = themeText
+		}
+		large := isLargeText(node, theme.Typography)
+		if !color.PassesAA(foreground, background, large) {
+			minimum := color.MinContrastNormalText
+			if large {
+				minimum = color.MinContrastLargeText
+			}
+			issues = append(issues, warnf(path,
+				"text color %s on background %s has contrast %.2f:1, below the WCAG AA minimum of %.1f:1",
+				foreground, background, color.ContrastRatio(foreground, background), minimum))
+		}
+	})
+	return issues
+}
+
+func nodeBackground(node *model.ComponentNode) (color.Color, bool) {
+	if node.Modifier != nil && node.Modifier.Background != nil {
+		if c, err := color.Parse(*node.Modifier.Background); err == nil {
+			return c, true
+		}
+	}
+	return nodeColor(node, "backgroundColor")
+}
+
+func nodeColor(node *model.ComponentNode, property string) (color.Color, bool) {
+	value, ok := node.Properties[property].(string)
+	if !ok {
+		return color.Color{}, false
+	}
+	c, err := color.Parse(value)
+	return c, err == nil
+}
+
+// isLargeText applies the WCAG definition of large text: at least 24px, or
+// at least 18.66px when bold.
+func isLargeText(node *model.ComponentNode, typography model.Typography) bool {
+	name, ok := node.Properties["style"].(string)
+	if !ok {
+		return false
+	}
+	style, ok := typography[model.TextStyleName(name)]
+	if !ok {
+		return false
+	}
+	return style.FontSize >= 24 || (style.FontSize >= 18.66 && style.FontWeight >= 700)
+}
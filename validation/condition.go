@@ -0,0 +1,188 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var conditionRoots = map[string]bool{
+	"state": true,
+	"item":  true,
+	"form":  true,
+}
+
+// parseCondition checks that expr follows the condition grammar documented
+// on model.ActionConfig and returns the references it reads.
+func parseCondition(expr string) ([]string, error) {
+	tokens, err := tokenizeCondition(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty condition")
+	}
+	p := &conditionParser{tokens: tokens}
+	if err := p.or(); err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	return p.refs, nil
+}
+
+type conditionTokenKind int
+
+const (
+	tokenOperator conditionTokenKind = iota
+	tokenReference
+	tokenLiteral
+)
+
+type conditionToken struct {
+	kind conditionTokenKind
+	text string
+}
+
+var conditionOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")"}
+
+func tokenizeCondition(expr string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexRune(expr[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, conditionToken{tokenLiteral, expr[i : i+end+2]})
+			i += end + 2
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(expr) && unicode.IsDigit(rune(expr[i+1]))):
+			start := i
+			for i++; i < len(expr) && (unicode.IsDigit(rune(expr[i])) || expr[i] == '.'); i++ {
+			}
+			tokens = append(tokens, conditionToken{tokenLiteral, expr[start:i]})
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for ; i < len(expr) && (unicode.IsLetter(rune(expr[i])) || unicode.IsDigit(rune(expr[i])) || expr[i] == '_' || expr[i] == '.'); i++ {
+			}
+			word := expr[start:i]
+			if word == "true" || word == "false" || word == "null" {
+				tokens = append(tokens, conditionToken{tokenLiteral, word})
+			} else {
+				tokens = append(tokens, conditionToken{tokenReference, word})
+			}
+		default:
+			matched := false
+			for _, op := range conditionOperators {
+				if strings.HasPrefix(expr[i:], op) {
+					tokens = append(tokens, conditionToken{tokenOperator, op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+	refs   []string
+}
+
+func (p *conditionParser) peekOperator(ops ...string) (string, bool) {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if p.tokens[p.pos].text == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *conditionParser) or() error {
+	if err := p.and(); err != nil {
+		return err
+	}
+	for {
+		if _, ok := p.peekOperator("||"); !ok {
+			return nil
+		}
+		p.pos++
+		if err := p.and(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *conditionParser) and() error {
+	if err := p.unary(); err != nil {
+		return err
+	}
+	for {
+		if _, ok := p.peekOperator("&&"); !ok {
+			return nil
+		}
+		p.pos++
+		if err := p.unary(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *conditionParser) unary() error {
+	if _, ok := p.peekOperator("!"); ok {
+		p.pos++
+		return p.unary()
+	}
+	if err := p.operand(); err != nil {
+		return err
+	}
+	if _, ok := p.peekOperator("==", "!=", "<", "<=", ">", ">="); ok {
+		p.pos++
+		return p.operand()
+	}
+	return nil
+}
+
+func (p *conditionParser) operand() error {
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("condition ends unexpectedly")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case tokenLiteral:
+		return nil
+	case tokenReference:
+		root, _, _ := strings.Cut(token.text, ".")
+		if !conditionRoots[root] || !strings.Contains(token.text, ".") || strings.HasSuffix(token.text, ".") {
+			return fmt.Errorf("reference %q must read a state., item. or form. value", token.text)
+		}
+		p.refs = append(p.refs, token.text)
+		return nil
+	}
+	if token.text != "(" {
+		return fmt.Errorf("unexpected %q", token.text)
+	}
+	if err := p.or(); err != nil {
+		return err
+	}
+	if _, ok := p.peekOperator(")"); !ok {
+		return fmt.Errorf("missing closing parenthesis")
+	}
+	p.pos++
+	return nil
+}
@@ -0,0 +1,114 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/form"
+	"github.com/nicholaspark09/ssr-go/model"
+	"regexp"
+	"time"
+)
+
+func checkForms(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if !form.IsField(*node) {
+			return
+		}
+		field, err := form.ParseField(*node)
+		if err != nil {
+			issues = append(issues, errorf(path, "%v", err))
+			return
+		}
+		issues = append(issues, checkField(field, path)...)
+	})
+	return issues
+}
+
+func checkField(field form.Field, path string) []Issue {
+	var issues []Issue
+	if (field.Type == "radio_group" || field.Type == "dropdown") && len(field.Options) == 0 {
+		issues = append(issues, errorf(path, "%s %q has no options", field.Type, field.Name))
+	}
+	seen := make(map[string]bool)
+	for _, option := range field.Options {
+		if seen[option.Value] {
+			issues = append(issues, errorf(path, "%s %q lists option value %q twice", field.Type, field.Name, option.Value))
+		}
+		seen[option.Value] = true
+	}
+
+	bounds := make(map[string]interface{})
+	for i, rule := range field.Rules {
+		rulePath := fmt.Sprintf("%s.properties.rules[%d]", path, i)
+		if rule.Message == "" {
+			issues = append(issues, warnf(rulePath, "%s rule has no message to show the user", rule.Type))
+		}
+		switch rule.Type {
+		case "required":
+		case "min", "max":
+			value, ok := boundValue(field.Type, rule.Value)
+			if !ok {
+				issues = append(issues, errorf(rulePath, "%s rule value %v does not fit %s", rule.Type, rule.Value, field.Type))
+				continue
+			}
+			bounds[rule.Type] = value
+		case "minLength", "maxLength":
+			if field.Type != "text_field" && field.Type != "password_field" {
+				issues = append(issues, errorf(rulePath, "%s only applies to text fields, not %s", rule.Type, field.Type))
+				continue
+			}
+			length, ok := rule.Value.(float64)
+			if !ok || length < 0 {
+				issues = append(issues, errorf(rulePath, "%s needs a non-negative length, got %v", rule.Type, rule.Value))
+				continue
+			}
+			bounds[rule.Type] = length
+		case "pattern":
+			pattern, ok := rule.Value.(string)
+			if !ok {
+				issues = append(issues, errorf(rulePath, "pattern rule needs a string value"))
+			} else if _, err := regexp.Compile("^(?:" + pattern + ")$"); err != nil {
+				issues = append(issues, errorf(rulePath, "pattern %q does not compile: %v", pattern, err))
+			}
+		default:
+			issues = append(issues, errorf(rulePath, "unknown validation rule %q", rule.Type))
+		}
+	}
+	if outOfOrder(bounds["min"], bounds["max"]) {
+		issues = append(issues, errorf(path, "%s %q has a min rule greater than its max rule", field.Type, field.Name))
+	}
+	if outOfOrder(bounds["minLength"], bounds["maxLength"]) {
+		issues = append(issues, errorf(path, "%s %q has a minLength rule greater than its maxLength rule", field.Type, field.Name))
+	}
+	return issues
+}
+
+// boundValue parses a min or max rule value the way form.Field.Validate will
+// compare it: numbers for numeric fields and ISO dates for date pickers. Rules
+// come from form.ParseField, so numbers have been decoded as float64.
+func boundValue(fieldType string, value interface{}) (interface{}, bool) {
+	switch fieldType {
+	case "number_field", "slider":
+		if v, ok := value.(float64); ok {
+			return v, true
+		}
+	case "date_picker":
+		if s, ok := value.(string); ok {
+			date, err := time.Parse(form.DateLayout, s)
+			return date, err == nil
+		}
+	}
+	return nil, false
+}
+
+func outOfOrder(min, max interface{}) bool {
+	switch lo := min.(type) {
+	case float64:
+		hi, ok := max.(float64)
+		return ok && lo > hi
+	case time.Time:
+		hi, ok := max.(time.Time)
+		return ok && lo.After(hi)
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+func checkGradients(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	if screen.Theme != nil {
+		for name, gradient := range screen.Theme.Gradients {
+			issues = append(issues, ValidateGradient(gradient, "theme.gradients."+name)...)
+		}
+	}
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if node.Modifier != nil && node.Modifier.Gradient != nil {
+			issues = append(issues, ValidateGradient(*node.Modifier.Gradient, path+".modifier.gradient")...)
+		}
+	})
+	return issues
+}
+
+// ValidateGradient checks that the fields set on a gradient make sense for
+// its type: angles and start/end points are linear only, radius is radial
+// only, and centers apply to radial and sweep gradients.
+func ValidateGradient(gradient model.GradientConfig, path string) []Issue {
+	var issues []Issue
+	fieldNotAllowed := func(field string) {
+		issues = append(issues, errorf(path, "%s is not supported by %s gradients", field, gradient.Type))
+	}
+
+	hasPoints := gradient.StartX != nil || gradient.StartY != nil || gradient.EndX != nil || gradient.EndY != nil
+	hasCenter := gradient.CenterX != nil || gradient.CenterY != nil
+	switch gradient.Type {
+	case "linear":
+		if gradient.Angle != nil && hasPoints {
+			issues = append(issues, errorf(path, "linear gradient sets both angle and start/end points"))
+		}
+		if hasPoints && (gradient.StartX == nil || gradient.StartY == nil || gradient.EndX == nil || gradient.EndY == nil) {
+			issues = append(issues, errorf(path, "linear gradient start/end points must set startX, startY, endX and endY"))
+		}
+		if gradient.Radius != nil {
+			fieldNotAllowed("radius")
+		}
+		if hasCenter {
+			fieldNotAllowed("center")
+		}
+	case "radial", "sweep":
+		if gradient.Angle != nil {
+			fieldNotAllowed("angle")
+		}
+		if hasPoints {
+			fieldNotAllowed("start/end points")
+		}
+		if gradient.Type == "sweep" && gradient.Radius != nil {
+			fieldNotAllowed("radius")
+		}
+		if gradient.Radius != nil && *gradient.Radius <= 0 {
+			issues = append(issues, errorf(path, "radial gradient radius must be positive"))
+		}
+	default:
+		issues = append(issues, errorf(path, "unknown gradient type %q", gradient.Type))
+	}
+
+	if len(gradient.Colors) < 2 {
+		issues = append(issues, errorf(path, "gradient needs at least two colors, got %d", len(gradient.Colors)))
+	}
+	if gradient.Positions != nil {
+		if len(gradient.Positions) != len(gradient.Colors) {
+			issues = append(issues, errorf(path, "gradient has %d positions for %d colors", len(gradient.Positions), len(gradient.Colors)))
+		}
+		for i, position := range gradient.Positions {
+			if position < 0 || position > 1 {
+				issues = append(issues, errorf(fmt.Sprintf("%s.positions[%d]", path, i), "position %g is outside 0-1", position))
+			}
+			if i > 0 && position < gradient.Positions[i-1] {
+				issues = append(issues, errorf(fmt.Sprintf("%s.positions[%d]", path, i), "positions must not decrease"))
+			}
+		}
+	}
+	return issues
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+var gridTypes = map[string]bool{
+	"lazy_vertical_grid": true,
+}
+
+func checkGrids(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if !gridTypes[node.Type] {
+			if node.Columns != nil || node.MinCellWidth != nil || node.Spans != nil {
+				issues = append(issues, errorf(path, "columns, minCellWidth and spans are only supported on grids, not %s", node.Type))
+			}
+			return
+		}
+
+		switch {
+		case node.Columns == nil && node.MinCellWidth == nil:
+			issues = append(issues, errorf(path, "grid needs either columns or minCellWidth"))
+		case node.Columns != nil && node.MinCellWidth != nil:
+			issues = append(issues, errorf(path, "grid sets both columns and minCellWidth"))
+		case node.Columns != nil && *node.Columns < 1:
+			issues = append(issues, errorf(path, "grid columns must be at least 1, got %d", *node.Columns))
+		case node.MinCellWidth != nil && (node.MinCellWidth.Value <= 0 || node.MinCellWidth.Unit == model.Wrap || node.MinCellWidth.Unit == model.Fill):
+			issues = append(issues, errorf(path, "invalid grid minCellWidth %s", node.MinCellWidth))
+		}
+
+		for componentType, span := range node.Spans {
+			spanPath := fmt.Sprintf("%s.spans.%s", path, componentType)
+			switch {
+			case span == model.FullLineSpan:
+			case span < 1:
+				issues = append(issues, errorf(spanPath, "span must be at least 1, got %d", span))
+			case node.Columns != nil && span > *node.Columns:
+				issues = append(issues, errorf(spanPath, "span %d exceeds the grid's %d columns", span, *node.Columns))
+			case node.MinCellWidth != nil && span > 1:
+				issues = append(issues, warnf(spanPath, "span %d in an adaptive grid may exceed the column count on narrow screens", span))
+			}
+		}
+
+		if node.DataSource == nil || node.ItemTemplate == nil {
+			issues = append(issues, errorf(path, "grid needs a dataSource and an itemTemplate"))
+		}
+	})
+	return issues
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+)
+
+// checkIDs requires explicit IDs to be unique within their scope: the screen,
+// or a template that is rendered once per item, section or placeholder.
+func checkIDs(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	seen := make(map[string]map[string]string)
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if node.ID == nil {
+			return
+		}
+		if *node.ID == "" {
+			issues = append(issues, errorf(path, "%s has an empty id", node.Type))
+			return
+		}
+		scope := tree.Scope(path)
+		if seen[scope] == nil {
+			seen[scope] = make(map[string]string)
+		}
+		if first, dup := seen[scope][*node.ID]; dup {
+			issues = append(issues, errorf(path, "id %q is already used at %s", *node.ID, first))
+			return
+		}
+		seen[scope][*node.ID] = path
+	})
+	return issues
+}
@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"sort"
+	"sync"
+)
+
+// ItemSchema describes the keys a data source item must carry when its
+// component_type selects a client-side renderer.
+type ItemSchema struct {
+	ComponentType string
+	Required      []string
+}
+
+var (
+	itemSchemasMu sync.RWMutex
+	itemSchemas   = map[string]ItemSchema{
+		"chart_bar":   {ComponentType: "chart_bar", Required: []string{"data"}},
+		"chart_line":  {ComponentType: "chart_line", Required: []string{"series"}},
+		"chart_pie":   {ComponentType: "chart_pie", Required: []string{"data"}},
+		"chart_radar": {ComponentType: "chart_radar", Required: []string{"data"}},
+		"spacer":      {ComponentType: "spacer", Required: []string{"height"}},
+	}
+)
+
+// RegisterItemSchema makes a component_type known to item validation, for
+// renderers that a particular client ships in addition to the built-in ones.
+func RegisterItemSchema(schema ItemSchema) {
+	itemSchemasMu.Lock()
+	defer itemSchemasMu.Unlock()
+	itemSchemas[schema.ComponentType] = schema
+}
+
+func lookupItemSchema(componentType string) (ItemSchema, bool) {
+	itemSchemasMu.RLock()
+	defer itemSchemasMu.RUnlock()
+	schema, ok := itemSchemas[componentType]
+	return schema, ok
+}
+
+func checkItems(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		issues = append(issues, ValidateItems(*node, path)...)
+	})
+	return issues
+}
+
+// ValidateItems checks the discriminators of a list's static items: every
+// component_type must have a schema or a template declared on the list, and
+// the schema's required keys must be present. The list's default template
+// must be declared too.
+func ValidateItems(node model.ComponentNode, path string) []Issue {
+	if node.DataSource == nil {
+		return nil
+	}
+	var issues []Issue
+	var templates map[string]model.ComponentNode
+	if node.ItemTemplate != nil {
+		templates = node.ItemTemplate.Templates
+		if name := node.ItemTemplate.Default; name != "" {
+			if _, ok := templates[name]; !ok {
+				issues = append(issues, errorf(path+".itemTemplate.default", "default template %q is not declared", name))
+			}
+		}
+	}
+
+	for i, item := range node.DataSource.Items {
+		itemPath := fmt.Sprintf("%s.dataSource.items[%d]", path, i)
+		if raw, ok := item["template"]; ok {
+			switch raw.(type) {
+			case model.ComponentNode, map[string]interface{}:
+			default:
+				issues = append(issues, errorf(itemPath, "template must be a component, got %T", raw))
+			}
+			continue
+		}
+
+		raw, ok := item["component_type"]
+		if !ok {
+			continue
+		}
+		componentType, ok := raw.(string)
+		if !ok || componentType == "" {
+			issues = append(issues, errorf(itemPath, "component_type must be a non-empty string, got %v", raw))
+			continue
+		}
+		if _, ok := templates[componentType]; ok {
+			continue
+		}
+		schema, ok := lookupItemSchema(componentType)
+		if !ok {
+			issues = append(issues, errorf(itemPath, "unknown component_type %q", componentType))
+			continue
+		}
+		var missing []string
+		for _, key := range schema.Required {
+			if value, ok := item[key]; !ok || value == nil {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			issues = append(issues, errorf(itemPath, "%s item is missing required keys %v", componentType, missing))
+		}
+	}
+	return issues
+}
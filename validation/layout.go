@@ -0,0 +1,116 @@
+package validation
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+var stackContainers = map[string]bool{
+	"box":   true,
+	"stack": true,
+}
+
+var boxAlignments = map[model.Alignment]bool{
+	model.AlignTopStart:     true,
+	model.AlignTopCenter:    true,
+	model.AlignTopEnd:       true,
+	model.AlignCenterStart:  true,
+	model.AlignCenter:       true,
+	model.AlignCenterEnd:    true,
+	model.AlignBottomStart:  true,
+	model.AlignBottomCenter: true,
+	model.AlignBottomEnd:    true,
+}
+
+func checkStackLayout(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, parent *model.ComponentNode, path string) {
+		if raw, ok := node.Properties["contentAlignment"]; ok {
+			if !stackContainers[node.Type] {
+				issues = append(issues, errorf(path, "contentAlignment requires a box or stack, not %s", node.Type))
+			} else if alignment, ok := raw.(string); !ok || !boxAlignments[model.Alignment(alignment)] {
+				issues = append(issues, errorf(path, "invalid contentAlignment %v", raw))
+			}
+		}
+
+		if node.Modifier == nil {
+			return
+		}
+		inStack := parent != nil && stackContainers[parent.Type]
+		if alignment := node.Modifier.Alignment; alignment != nil {
+			if !inStack {
+				issues = append(issues, errorf(path+".modifier", "alignment %q requires a box or stack parent", *alignment))
+			} else if !boxAlignments[*alignment] {
+				issues = append(issues, errorf(path+".modifier", "invalid alignment %q inside %s", *alignment, parent.Type))
+			}
+		}
+		if node.Modifier.ZIndex != nil && !inStack {
+			issues = append(issues, errorf(path+".modifier", "zIndex requires a box or stack parent"))
+		}
+	})
+	return issues
+}
+
+type axis int
+
+const (
+	horizontalAxis axis = iota
+	verticalAxis
+)
+
+// mainAxes maps the containers that accept arrangement and alignment to the
+// direction in which they lay out their children.
+var mainAxes = map[string]axis{
+	"row":                   horizontalAxis,
+	"lazy_row":              horizontalAxis,
+	"column":                verticalAxis,
+	"scrollable_column":     verticalAxis,
+	"lazy_column":           verticalAxis,
+	"enhanced_lazy_column":  verticalAxis,
+	"sectioned_lazy_column": verticalAxis,
+}
+
+var arrangements = map[model.Arrangement]bool{
+	model.ArrangeStart:  true,
+	model.ArrangeEnd:    true,
+	model.ArrangeCenter: true,
+	model.SpaceBetween:  true,
+	model.SpaceAround:   true,
+	model.SpaceEvenly:   true,
+}
+
+var crossAxisAlignments = map[axis]map[model.Alignment]bool{
+	horizontalAxis: {
+		model.AlignTop:              true,
+		model.AlignCenterVertically: true,
+		model.AlignBottom:           true,
+	},
+	verticalAxis: {
+		model.AlignStart:              true,
+		model.AlignCenterHorizontally: true,
+		model.AlignEnd:                true,
+	},
+}
+
+func checkArrangement(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		mainAxis, isLinear := mainAxes[node.Type]
+		if arrangement := node.Arrangement; arrangement != nil {
+			if !isLinear {
+				issues = append(issues, errorf(path, "arrangement is only supported on rows and columns, not %s", node.Type))
+			} else if space, ok := arrangement.Spacing(); ok {
+				if space.Unit == model.Wrap || space.Unit == model.Fill || space.Value < 0 {
+					issues = append(issues, errorf(path, "invalid spacing in arrangement %q", *arrangement))
+				}
+			} else if !arrangements[*arrangement] {
+				issues = append(issues, errorf(path, "unknown arrangement %q", *arrangement))
+			}
+		}
+		if alignment := node.Alignment; alignment != nil {
+			if !isLinear {
+				issues = append(issues, errorf(path, "alignment is only supported on rows and columns, not %s", node.Type))
+			} else if !crossAxisAlignments[mainAxis][*alignment] {
+				issues = append(issues, errorf(path, "alignment %q is not valid for %s", *alignment, node.Type))
+			}
+		}
+	})
+	return issues
+}
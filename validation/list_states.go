@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"strings"
+)
+
+var loadingTypes = map[string]bool{
+	"spinner":  true,
+	"shimmer":  true,
+	"skeleton": true,
+}
+
+func checkListStates(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		for name, action := range node.Actions {
+			if action.Type == "retry" && !strings.Contains(path, ".errorTemplate") {
+				issues = append(issues, warnf(path+".actions."+name, "retry action outside an error template has no list to reload"))
+			}
+		}
+
+		if loading := node.LoadingTemplate; loading != nil {
+			if !loadingTypes[loading.Type] {
+				issues = append(issues, errorf(path+".loadingTemplate", "unknown loading type %q", loading.Type))
+			}
+			if loading.Type != "spinner" && (loading.Count == nil || *loading.Count < 1) {
+				issues = append(issues, errorf(path+".loadingTemplate", "%s loading needs a placeholder count of at least 1", loading.Type))
+			}
+		}
+
+		if node.DataSource == nil || node.DataSource.Type != "api" {
+			return
+		}
+		if node.ErrorTemplate == nil {
+			issues = append(issues, warnf(path, "API-backed %s has no errorTemplate", node.Type))
+		}
+		if node.EmptyTemplate == nil {
+			issues = append(issues, warnf(path, "API-backed %s has no emptyTemplate", node.Type))
+		}
+	})
+	return issues
+}
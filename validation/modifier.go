@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+var shapeTypes = map[string]bool{
+	"rectangle": true,
+	"rounded":   true,
+	"circle":    true,
+	"pill":      true,
+}
+
+func checkModifiers(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		if node.Modifier != nil {
+			issues = append(issues, ValidateModifier(*node.Modifier, path+".modifier")...)
+			if len(node.ModifierChain) > 0 {
+				issues = append(issues, warnf(path, "node sets both modifier and modifierChain; clients apply only one of them"))
+			}
+		}
+		for i, modifier := range node.ModifierChain {
+			issues = append(issues, ValidateModifier(modifier, fmt.Sprintf("%s.modifierChain[%d]", path, i))...)
+		}
+	})
+	return issues
+}
+
+func ValidateModifier(modifier model.ModifierConfig, path string) []Issue {
+	var issues []Issue
+	for _, conflict := range ModifierConflicts(modifier) {
+		issues = append(issues, warnf(path, "%s", conflict))
+	}
+	if modifier.Alpha != nil && (*modifier.Alpha < 0 || *modifier.Alpha > 1) {
+		issues = append(issues, errorf(path, "alpha %g is outside 0-1", *modifier.Alpha))
+	}
+	if modifier.AspectRatio != nil && *modifier.AspectRatio <= 0 {
+		issues = append(issues, errorf(path, "aspectRatio must be positive"))
+	}
+	if exceeds(modifier.MinWidth, modifier.MaxWidth) {
+		issues = append(issues, errorf(path, "minWidth %s is greater than maxWidth %s", modifier.MinWidth, modifier.MaxWidth))
+	}
+	if exceeds(modifier.MinHeight, modifier.MaxHeight) {
+		issues = append(issues, errorf(path, "minHeight %s is greater than maxHeight %s", modifier.MinHeight, modifier.MaxHeight))
+	}
+	dimensions := []struct {
+		name  string
+		value *model.Dimension
+	}{
+		{"padding", modifier.Padding},
+		{"paddingStart", modifier.PaddingStart},
+		{"paddingTop", modifier.PaddingTop},
+		{"paddingEnd", modifier.PaddingEnd},
+		{"paddingBottom", modifier.PaddingBottom},
+	}
+	if border := modifier.Border; border != nil {
+		dimensions = append(dimensions, []struct {
+			name  string
+			value *model.Dimension
+		}{{"border width", &border.Width}, {"border radius", border.Radius}}...)
+	}
+	if modifier.Shape != nil {
+		dimensions = append(dimensions, struct {
+			name  string
+			value *model.Dimension
+		}{"shape cornerRadius", modifier.Shape.CornerRadius})
+	}
+	for _, d := range dimensions {
+		if d.value == nil {
+			continue
+		}
+		if d.value.Unit == model.Wrap || d.value.Unit == model.Fill {
+			issues = append(issues, errorf(path, "%s cannot be %s", d.name, d.value))
+		}
+		if d.value.Value < 0 {
+			issues = append(issues, errorf(path, "%s must not be negative", d.name))
+		}
+	}
+	if shape := modifier.Shape; shape != nil {
+		if !shapeTypes[shape.Type] {
+			issues = append(issues, errorf(path+".shape", "unknown shape %q", shape.Type))
+		}
+		if shape.Type == "rounded" && shape.CornerRadius == nil {
+			issues = append(issues, errorf(path+".shape", "rounded shape needs a cornerRadius"))
+		}
+	}
+	if modifier.Shadow != nil && modifier.Shadow.Elevation < 0 {
+		issues = append(issues, errorf(path+".shadow", "shadow elevation must not be negative"))
+	}
+	return issues
+}
+
+// ModifierConflicts lists field combinations that clients resolve differently,
+// typically the result of merging modifiers from several sources.
+func ModifierConflicts(modifier model.ModifierConfig) []string {
+	var conflicts []string
+	if modifier.Padding != nil {
+		sides := []struct {
+			name string
+			set  bool
+		}{
+			{"paddingStart", modifier.PaddingStart != nil},
+			{"paddingTop", modifier.PaddingTop != nil},
+			{"paddingEnd", modifier.PaddingEnd != nil},
+			{"paddingBottom", modifier.PaddingBottom != nil},
+		}
+		for _, side := range sides {
+			if side.set {
+				conflicts = append(conflicts, fmt.Sprintf("padding conflicts with %s", side.name))
+			}
+		}
+	}
+	fillsWidth := modifier.FillMaxWidth != nil && *modifier.FillMaxWidth
+	fillsSize := modifier.FillMaxSize != nil && *modifier.FillMaxSize
+	if (fillsWidth || fillsSize) && modifier.Width != nil {
+		conflicts = append(conflicts, "width conflicts with filling the max width")
+	}
+	if fillsSize && modifier.Height != nil {
+		conflicts = append(conflicts, "height conflicts with fillMaxSize")
+	}
+	if modifier.Weight != nil && (fillsWidth || fillsSize) {
+		conflicts = append(conflicts, "weight conflicts with filling the max size")
+	}
+	if modifier.Background != nil && modifier.Gradient != nil {
+		conflicts = append(conflicts, "background conflicts with gradient")
+	}
+	if modifier.Width != nil && modifier.Height != nil && modifier.AspectRatio != nil {
+		conflicts = append(conflicts, "aspectRatio conflicts with a fixed width and height")
+	}
+	return conflicts
+}
+
+// exceeds reports whether min is greater than max. Dimensions in different
+// units can only be compared on the client, so they never exceed each other.
+func exceeds(min, max *model.Dimension) bool {
+	if min == nil || max == nil || min.Unit != max.Unit {
+		return false
+	}
+	return min.Value > max.Value
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+)
+
+func checkSections(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		sections := node.Sections
+		if node.Type != "sectioned_lazy_column" {
+			if sections != nil {
+				issues = append(issues, errorf(path, "sections are only supported on sectioned_lazy_column, not %s", node.Type))
+			}
+			return
+		}
+		if sections == nil {
+			issues = append(issues, errorf(path, "sectioned_lazy_column needs a sections config"))
+			return
+		}
+		if sections.GroupBy == "" {
+			issues = append(issues, errorf(path+".sections", "sections need a groupBy key"))
+			return
+		}
+		if node.DataSource == nil || node.DataSource.Type != "static" {
+			return
+		}
+
+		// Each section must be contiguous or the client renders its header twice.
+		closed := make(map[string]bool)
+		previous := ""
+		for i, item := range node.DataSource.Items {
+			itemPath := fmt.Sprintf("%s.dataSource.items[%d]", path, i)
+			group := ""
+			if value, ok := item[sections.GroupBy]; ok && value != nil {
+				group = fmt.Sprint(value)
+			} else {
+				issues = append(issues, warnf(itemPath, "item has no %q value and falls into an unnamed section", sections.GroupBy))
+			}
+			if i > 0 && group != previous {
+				closed[previous] = true
+				if closed[group] {
+					issues = append(issues, errorf(itemPath, "item reopens section %q; group items with ui.GroupItems", group))
+				}
+			}
+			previous = group
+		}
+	})
+	return issues
+}
@@ -0,0 +1,32 @@
+package validation
+
+import "github.com/nicholaspark09/ssr-go/model"
+
+func checkTextStyles(screen model.ComponentScreen) []Issue {
+	var typography model.Typography
+	if screen.Theme != nil {
+		typography = screen.Theme.Typography
+	}
+
+	var issues []Issue
+	walkScreen(screen, func(node, _ *model.ComponentNode, path string) {
+		raw, ok := node.Properties["style"]
+		if !ok {
+			return
+		}
+		var style model.TextStyleName
+		switch v := raw.(type) {
+		case string:
+			style = model.TextStyleName(v)
+		case model.TextStyleName:
+			style = v
+		default:
+			issues = append(issues, errorf(path, "style must be a string, got %T", raw))
+			return
+		}
+		if _, ok := typography[style]; !ok {
+			issues = append(issues, errorf(path, "text style %q is not defined in the theme typography", style))
+		}
+	})
+	return issues
+}
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/tree"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Path     string   `json:"path"`
+	Message  string   `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s at %s: %s", i.Severity, i.Path, i.Message)
+}
+
+type screenCheck func(screen model.ComponentScreen) []Issue
+
+var screenChecks = []screenCheck{
+	checkTextStyles,
+	checkColors,
+	checkContrast,
+	checkGradients,
+	checkModifiers,
+	checkStackLayout,
+	checkArrangement,
+	checkGrids,
+	checkListStates,
+	checkSections,
+	checkItems,
+	checkForms,
+	checkActions,
+	checkIDs,
+}
+
+func ValidateScreen(screen model.ComponentScreen) []Issue {
+	var issues []Issue
+	for _, check := range screenChecks {
+		issues = append(issues, check(screen)...)
+	}
+	return issues
+}
+
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func errorf(path, format string, args ...interface{}) Issue {
+	return Issue{Severity: SeverityError, Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func warnf(path, format string, args ...interface{}) Issue {
+	return Issue{Severity: SeverityWarning, Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+type visitFunc func(node, parent *model.ComponentNode, path string)
+
+func walkScreen(screen model.ComponentScreen, fn visitFunc) {
+	tree.WalkScreen(&screen, func(node, parent *model.ComponentNode, path string) error {
+		fn(node, parent, path)
+		return nil
+	})
+}
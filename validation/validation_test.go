@@ -0,0 +1,567 @@
+package validation
+
+import (
+	"github.com/nicholaspark09/ssr-go/model"
+	"github.com/nicholaspark09/ssr-go/ui"
+	"strings"
+	"testing"
+)
+
+func issuesContaining(issues []Issue, substr string) []Issue {
+	var matched []Issue
+	for _, issue := range issues {
+		if strings.Contains(issue.Message, substr) {
+			matched = append(matched, issue)
+		}
+	}
+	return matched
+}
+
+func TestTextStyleValidation(t *testing.T) {
+	layout := ui.Column(
+		ui.StyledText("Title", model.Headline2),
+		ui.StyledText("Fine print", "legalese"),
+	)
+
+	tests := []struct {
+		name       string
+		screen     model.ComponentScreen
+		wantErrors int
+	}{
+		{
+			name:       "No typography declared",
+			screen:     ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build(),
+			wantErrors: 2,
+		},
+		{
+			name: "Default typography",
+			screen: ui.NewScreen("s", "S", "1.0").
+				WithLayout(layout).
+				WithTypography(ui.DefaultTypography("Inter")).
+				Build(),
+			wantErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := issuesContaining(ValidateScreen(tt.screen), "text style")
+			if len(issues) != tt.wantErrors {
+				t.Fatalf("expected %d text style issues, got %d: %v", tt.wantErrors, len(issues), issues)
+			}
+			if !HasErrors(issues) {
+				t.Error("expected undefined styles to be reported as errors")
+			}
+		})
+	}
+}
+
+func TestColorValidation(t *testing.T) {
+	screen := ui.NewScreen("s", "S", "1.0").
+		WithLayout(ui.Column(
+			ui.BarChart("Chart", []model.ChartDataPoint{
+				{Label: "Mon", Value: 6, Color: ui.StringPtr("#FF9F4")},
+				{Label: "Tue", Value: 7, Color: ui.StringPtr("orange")},
+				{Label: "Wed", Value: 5, Color: ui.StringPtr("#FF9F43")},
+			}),
+			ui.NewComponent("card").
+				WithProperty("backgroundColor", "#FFFFFF").
+				WithChildren(
+					ui.NewComponent("text").
+						WithProperty("text", "Faint").
+						WithProperty("color", "#CCCCCC").
+						Build(),
+					ui.NewComponent("text").
+						WithProperty("text", "Tokenized").
+						WithProperty("color", "{color.text.primary}").
+						Build(),
+				).
+				Build(),
+		)).
+		WithTheme(model.ThemeConfig{
+			PrimaryColor:    "{color.brand.primary}",
+			SecondaryColor:  "#10B981",
+			BackgroundColor: "#F9FAFB",
+			TextColor:       "#E5E7EB",
+		}).
+		Build()
+
+	issues := ValidateScreen(screen)
+
+	var colorErrors, contrastWarnings int
+	for _, issue := range issues {
+		switch {
+		case issue.Severity == SeverityError && strings.Contains(issue.Path, ".color"):
+			colorErrors++
+		case issue.Severity == SeverityWarning && strings.Contains(issue.Message, "contrast"):
+			contrastWarnings++
+		}
+	}
+	if colorErrors != 2 {
+		t.Errorf("Expected 2 invalid chart colors, got %d: %v", colorErrors, issues)
+	}
+	if contrastWarnings != 2 {
+		t.Errorf("Expected contrast warnings for the theme and the card text, got %d: %v", contrastWarnings, issues)
+	}
+}
+
+func TestGradientValidation(t *testing.T) {
+	invalidPositions := ui.LinearGradient(90, ui.Stop("#FFFFFF", 0.8), ui.Stop("#000000", 0.2))
+
+	tests := []struct {
+		name       string
+		gradient   model.GradientConfig
+		wantIssues int
+	}{
+		{"Linear", ui.LinearGradient(45, ui.Stops("#FFFFFF", "#000000")...), 0},
+		{"Radial", ui.RadialGradient(100, ui.Stops("#FFFFFF", "#000000")...), 0},
+		{"Sweep", ui.SweepGradientAt(0.5, 0.5, ui.Stops("#FFFFFF", "#000000")...), 0},
+		{"Angle on radial", model.GradientConfig{Type: "radial", Colors: []string{"#FFF", "#000"}, Angle: ui.Float32Ptr(45)}, 1},
+		{"Radius on linear", model.GradientConfig{Type: "linear", Colors: []string{"#FFF", "#000"}, Radius: ui.Float32Ptr(10)}, 1},
+		{"Single color", ui.LinearGradient(0, ui.Stops("#FFFFFF")...), 1},
+		{"Decreasing positions", invalidPositions, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := ValidateGradient(tt.gradient, "gradient")
+			if len(issues) != tt.wantIssues {
+				t.Errorf("Expected %d issues, got %d: %v", tt.wantIssues, len(issues), issues)
+			}
+		})
+	}
+}
+
+func TestModifierConflicts(t *testing.T) {
+	merged := ui.NewComponent("card").
+		WithModifier(ui.NewModifier().PaddingSymmetric(16, 8).Build()).
+		WithModifier(ui.PaddingModifier(12)).
+		WithModifier(ui.FillMaxWidthModifier()).
+		WithModifier(ui.SizeModifier(100, 40)).
+		Build()
+
+	conflicts := ModifierConflicts(*merged.Modifier)
+	if len(conflicts) != 5 {
+		t.Errorf("Expected 4 padding conflicts and a width conflict, got %v", conflicts)
+	}
+
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(merged).Build()
+	if issues := issuesContaining(ValidateScreen(screen), "conflicts"); len(issues) != len(conflicts) {
+		t.Errorf("Expected each conflict to be reported, got %v", issues)
+	}
+}
+
+func TestStackAlignmentValidation(t *testing.T) {
+	screen := ui.NewScreen("s", "S", "1.0").
+		WithLayout(ui.Column(
+			ui.Box(
+				ui.Aligned(ui.Text("Badge"), model.AlignTopEnd),
+			),
+			ui.Aligned(ui.Text("Misplaced"), model.AlignCenter),
+			ui.ZIndexed(ui.Text("Also misplaced"), 2),
+			ui.NewComponent("row").WithContentAlignment(model.AlignCenter).Build(),
+		)).
+		Build()
+
+	issues := ValidateScreen(screen)
+	var stackIssues []Issue
+	for _, issue := range issues {
+		if strings.Contains(issue.Message, "box or stack") {
+			stackIssues = append(stackIssues, issue)
+		}
+	}
+	if len(stackIssues) != 3 {
+		t.Errorf("Expected 3 stack-only issues, got %d: %v", len(stackIssues), issues)
+	}
+	for _, issue := range stackIssues {
+		if strings.Contains(issue.Path, "children[0].children[0]") {
+			t.Errorf("Alignment inside a box should be allowed: %v", issue)
+		}
+	}
+}
+
+func TestArrangementValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		node       model.ComponentNode
+		wantIssues int
+	}{
+		{"Row space between", ui.Row().Arranged(model.SpaceBetween).WithChildAlignment(model.AlignBottom), 0},
+		{"Column spaced by", ui.Column().Arranged(ui.SpacedBy(12)).WithChildAlignment(model.AlignEnd), 0},
+		{"Row with horizontal alignment", ui.Row().WithChildAlignment(model.AlignCenterHorizontally), 1},
+		{"Column with vertical alignment", ui.Column().WithChildAlignment(model.AlignTop), 1},
+		{"Card with arrangement", ui.Card().Arranged(model.SpaceEvenly), 1},
+		{"Unknown arrangement", ui.Row().Arranged("justified"), 1},
+		{"Fill spacing", ui.Row().Arranged(model.SpacedBy(ui.FillParent())), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := ui.NewScreen("s", "S", "1.0").WithLayout(tt.node).Build()
+			issues := checkArrangement(screen)
+			if len(issues) != tt.wantIssues {
+				t.Errorf("Expected %d issues, got %d: %v", tt.wantIssues, len(issues), issues)
+			}
+		})
+	}
+}
+
+func TestGridValidation(t *testing.T) {
+	dataSource := ui.StaticDataSource(nil)
+	template := model.ItemTemplate{Type: "photo", Layout: ui.Image("{{url}}")}
+
+	tests := []struct {
+		name       string
+		node       model.ComponentNode
+		wantIssues int
+	}{
+		{"Fixed grid", ui.LazyVerticalGrid(3, dataSource, template), 0},
+		{"Adaptive grid", ui.AdaptiveLazyVerticalGrid(ui.Dp(100), dataSource, template), 0},
+		{"Span wider than grid", ui.LazyGrid(ui.FixedColumns(2), dataSource, template).WithSpan("banner", 3).Build(), 1},
+		{"Zero columns", ui.LazyVerticalGrid(0, dataSource, template), 1},
+		{"Columns on a column", ui.NewComponent("column").WithColumns(2).Build(), 1},
+		{"Grid without cells", ui.NewComponent("lazy_vertical_grid").WithDataSource(dataSource).WithItemTemplate(template).Build(), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := ui.NewScreen("s", "S", "1.0").WithLayout(tt.node).Build()
+			issues := checkGrids(screen)
+			if len(issues) != tt.wantIssues {
+				t.Errorf("Expected %d issues, got %d: %v", tt.wantIssues, len(issues), issues)
+			}
+		})
+	}
+}
+
+func TestListStateValidation(t *testing.T) {
+	template := model.ItemTemplate{Type: "row", Layout: ui.Text("{{title}}")}
+	api := ui.APIDataSource("https://api.example.com/feed", "GET")
+
+	tests := []struct {
+		name         string
+		node         model.ComponentNode
+		wantWarnings int
+		wantErrors   int
+	}{
+		{"Static list", ui.LazyColumn(ui.StaticDataSource(nil), template), 0, 0},
+		{"Bare API list", ui.LazyColumn(api, template), 2, 0},
+		{
+			name: "API list with states",
+			node: ui.LazyColumnWithStates(api, template, ui.ListStates{
+				Loading: &model.LoadingState{Type: "shimmer", Count: ui.IntPtr(3)},
+				Empty:   ui.NodePtr(ui.EmptyState("Nothing here yet")),
+				Error:   ui.NodePtr(ui.ErrorState("Could not load the feed", "Retry")),
+			}),
+		},
+		{
+			name: "Skeleton without count",
+			node: ui.NewComponent("lazy_column").
+				WithDataSource(ui.StaticDataSource(nil)).
+				WithItemTemplate(template).
+				WithLoadingTemplate(model.LoadingState{Type: "skeleton"}).
+				Build(),
+			wantErrors: 1,
+		},
+		{"Retry outside error state", ui.Button("Retry", ui.RetryAction()), 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := ui.NewScreen("s", "S", "1.0").WithLayout(tt.node).Build()
+			var warnings, errors int
+			for _, issue := range checkListStates(screen) {
+				if issue.Severity == SeverityWarning {
+					warnings++
+				} else {
+					errors++
+				}
+			}
+			if warnings != tt.wantWarnings || errors != tt.wantErrors {
+				t.Errorf("Expected %d warnings and %d errors, got %d and %d", tt.wantWarnings, tt.wantErrors, warnings, errors)
+			}
+		})
+	}
+}
+
+func TestSectionValidation(t *testing.T) {
+	items := []map[string]interface{}{
+		{"day": "Mon", "event": "Standup"},
+		{"day": "Tue", "event": "Review"},
+		{"day": "Mon", "event": "Retro"},
+	}
+	header := ui.Text(ui.SectionKeyPlaceholder)
+	template := model.ItemTemplate{Type: "event", Layout: ui.Text("{{event}}")}
+
+	ungrouped := ui.SectionedLazyColumn(ui.StaticDataSource(items), ui.Sections("day", header), template)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(ungrouped).Build()
+	if issues := checkSections(screen); len(issues) != 1 || !strings.Contains(issues[0].Path, "items[2]") {
+		t.Errorf("Expected item 2 to be reported for reopening a section, got %v", issues)
+	}
+
+	grouped := ui.SectionedLazyColumn(ui.SectionedStaticDataSource(items, "day"), ui.Sections("day", header), template)
+	screen = ui.NewScreen("s", "S", "1.0").WithLayout(grouped).Build()
+	if issues := checkSections(screen); len(issues) != 0 {
+		t.Errorf("Expected grouped items to validate, got %v", issues)
+	}
+}
+
+func TestItemDiscriminatorValidation(t *testing.T) {
+	items := []map[string]interface{}{
+		ui.ChartBarItem("Sales", "This week", []model.ChartDataPoint{{Label: "Mon", Value: 3}}),
+		ui.SpacerItem(24),
+		ui.ItemWithComponentType(map[string]interface{}{"title": "No data"}, "chart_bar"),
+		ui.ItemWithComponentType(map[string]interface{}{"message": "Hi"}, "custom_type"),
+		ui.ItemWithComponentType(map[string]interface{}{"name": "Alice"}, "contact"),
+		ui.ItemWithComponentType(map[string]interface{}{}, "spacer"),
+		ui.ItemWithTemplate(map[string]interface{}{"title": "Inline"}, ui.Text("{{title}}")),
+	}
+
+	list := ui.NewComponent("enhanced_lazy_column").
+		WithDataSource(ui.EnhancedStaticDataSource(items)).
+		WithItemTemplate(model.ItemTemplate{Type: "default", Layout: ui.Text("{{title}}")}).
+		WithItemTemplateFor("contact", ui.Text("{{name}}")).
+		Build()
+
+	issues := ValidateItems(list, "list")
+	reported := map[string]bool{}
+	for _, issue := range issues {
+		reported[issue.Path] = true
+	}
+	for _, index := range []string{"2", "3", "5"} {
+		if !reported["list.dataSource.items["+index+"]"] {
+			t.Errorf("Expected item %s to be reported, got %v", index, issues)
+		}
+	}
+	if len(issues) != 3 {
+		t.Errorf("Expected 3 issues, got %v", issues)
+	}
+
+	RegisterItemSchema(ItemSchema{ComponentType: "custom_type", Required: []string{"message"}})
+	defer func() {
+		itemSchemasMu.Lock()
+		delete(itemSchemas, "custom_type")
+		itemSchemasMu.Unlock()
+	}()
+	if issues := ValidateItems(list, "list"); len(issues) != 2 {
+		t.Errorf("Expected registered custom_type to validate, got %v", issues)
+	}
+
+	list.ItemTemplate.Default = "missing"
+	if issues := ValidateItems(list, "list"); len(issuesContaining(issues, `default template "missing"`)) != 1 {
+		t.Errorf("Expected an undeclared default template to be reported, got %v", issues)
+	}
+}
+
+func TestFormValidation(t *testing.T) {
+	layout := ui.Column(
+		ui.TextField("email", "Email").WithRules(ui.Pattern(`[`, "Invalid email")).Build(),
+		ui.NumberField("qty", "Quantity").WithRules(ui.Min(10, "Too few"), ui.Max(5, "Too many")).Build(),
+		ui.Dropdown("size", "Size").Build(),
+		ui.Checkbox("email", "Subscribe").WithRules(ui.MinLength(1, "")).Build(),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := checkForms(screen)
+
+	for _, substr := range []string{"does not compile", "min rule greater than its max", "has no options", "only applies to text fields"} {
+		if len(issuesContaining(issues, substr)) != 1 {
+			t.Errorf("Expected one issue containing %q, got %v", substr, issues)
+		}
+	}
+	if len(issuesContaining(issues, "no message")) != 1 {
+		t.Errorf("Expected a warning for the rule without a message, got %v", issues)
+	}
+}
+
+func TestSubmitFormFieldNames(t *testing.T) {
+	layout := ui.Column(
+		ui.Card(
+			ui.TextField("email", "Email").Build(),
+			ui.TextField("note", "Note").Build(),
+			ui.Button("Sign up", ui.SubmitForm("/api/signup", "email").Build()),
+		),
+		ui.Card(
+			ui.TextField("email", "Email").Build(),
+			ui.TextField("note", "Note").Build(),
+			ui.TextField("query", "Search").Build(),
+			ui.Button("Search", ui.SubmitForm("/api/search", "query").Build()),
+		),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := append(checkForms(screen), checkActions(screen)...)
+
+	if matched := issuesContaining(issues, `collects "email" but 2 fields`); len(matched) != 1 || !strings.Contains(matched[0].Path, "children[0]") {
+		t.Errorf("Expected the shared collected name to be reported on the sign-up action, got %v", issues)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected names no action collects to be allowed twice, got %v", issues)
+	}
+}
+
+func TestSubmitFormValidation(t *testing.T) {
+	valid := ui.SubmitForm("/api/signup", "email").
+		OnSuccess(ui.NavigationAction("welcome")).
+		OnError(ui.ShowMessageAction("Could not sign up")).
+		Build()
+	invalid := ui.SubmitForm("", "email", "phone").
+		Method("FETCH").
+		OnError(ui.ShowMessageAction("")).
+		Build()
+
+	layout := ui.Column(
+		ui.TextField("email", "Email").Build(),
+		ui.Button("Sign up", valid),
+		ui.Button("Broken", invalid),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := checkActions(screen)
+
+	for _, substr := range []string{"needs an endpoint", "unknown HTTP method", `collects "phone"`, "show_message needs a message"} {
+		if matched := issuesContaining(issues, substr); len(matched) != 1 || !strings.Contains(matched[0].Path, "children[2]") {
+			t.Errorf("Expected one issue containing %q on the broken button, got %v", substr, issues)
+		}
+	}
+	if len(issues) != 4 {
+		t.Errorf("Expected 4 issues, got %v", issues)
+	}
+}
+
+func TestAPICallValidation(t *testing.T) {
+	like := ui.APICallTo("POST", "like").
+		BodyField("postId", "{{id}}").
+		Optimistic(map[string]interface{}{"liked": true}).
+		OnError(ui.ShowMessageAction("Could not like post")).
+		Build()
+	list := ui.NewComponent("lazy_column").
+		WithDataSource(ui.StaticDataSource([]map[string]interface{}{{"id": "1"}})).
+		WithItemTemplate(model.ItemTemplate{
+			Type:    "post",
+			Layout:  ui.Text("{{id}}"),
+			Actions: map[string]model.ActionConfig{"onLike": like},
+		}).
+		Build()
+
+	layout := ui.Column(
+		list,
+		ui.Button("Bare", ui.APICallAction()),
+		ui.Button("Unbound", ui.APICall("GET", "/api/refresh").BodyField("id", "{{id}}").Build()),
+		ui.Button("Missing key", ui.APICallTo("DELETE", "purge").Build()),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	screen.Data = &model.DataConfig{APIEndpoints: map[string]string{"like": "/api/posts/like"}}
+	issues := checkActions(screen)
+
+	for _, issue := range issues {
+		if strings.Contains(issue.Path, "children[0]") {
+			t.Errorf("Expected item template api_call to validate, got %v", issue)
+		}
+	}
+	for _, substr := range []string{"needs an endpoint or endpointKey", "api_call needs a method", "usually ignore a body", "{{id}} has no item data", `endpointKey "purge"`} {
+		if len(issuesContaining(issues, substr)) != 1 {
+			t.Errorf("Expected one issue containing %q, got %v", substr, issues)
+		}
+	}
+}
+
+func TestCompositeActionValidation(t *testing.T) {
+	checkout := ui.Sequence(
+		ui.AnalyticsAction("checkout_tapped", nil),
+		ui.If("form.quantity > 0 && state.loggedIn",
+			ui.Confirm("Place order", "Pay now?", ui.APICall("POST", "/api/orders").Build()).Build(),
+		).Else(ui.NavigationAction("login")).Build(),
+	)
+	broken := ui.Parallel(
+		ui.Sequence(),
+		ui.If("item.stock >", ui.NavigationAction("cart")).Build(),
+		ui.If("form.coupon == 'FREE'", ui.NavigationAction("cart")).Build(),
+		ui.NewAction("confirm").Build(),
+	)
+
+	layout := ui.Column(
+		ui.NumberField("quantity", "Quantity").Build(),
+		ui.Button("Checkout", checkout),
+		ui.Button("Broken", broken),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := checkActions(screen)
+
+	for _, issue := range issues {
+		if strings.Contains(issue.Path, "children[1]") {
+			t.Errorf("Expected checkout sequence to validate, got %v", issue)
+		}
+	}
+	for _, substr := range []string{"sequence has no actions", "condition ends unexpectedly", "form.coupon reads a field", "confirm needs a dialog message", "confirm needs a then action"} {
+		if len(issuesContaining(issues, substr)) != 1 {
+			t.Errorf("Expected one issue containing %q, got %v", substr, issues)
+		}
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		expr    string
+		refs    int
+		wantErr bool
+	}{
+		{expr: "state.loggedIn", refs: 1},
+		{expr: "!(item.price >= 10.5 || item.tag == \"sale\") && form.agree != false", refs: 3},
+		{expr: "item.count > -1", refs: 1},
+		{expr: "user.name == 'x'", wantErr: true},
+		{expr: "state.a &&", wantErr: true},
+		{expr: "(state.a", wantErr: true},
+		{expr: "state.a == 'open", wantErr: true},
+		{expr: "state.a = 1", wantErr: true},
+	}
+	for _, tt := range tests {
+		refs, err := parseCondition(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseCondition(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && len(refs) != tt.refs {
+			t.Errorf("parseCondition(%q) refs = %v, want %d", tt.expr, refs, tt.refs)
+		}
+	}
+}
+
+func TestActionIDValidation(t *testing.T) {
+	dispatched := ui.Dispatch("/actions", "like_post").Param("postId", "7").Build()
+	misplaced := ui.NavigationAction("home")
+	misplaced.ActionID = ui.StringPtr("go_home")
+
+	layout := ui.Column(ui.Button("Like", dispatched), ui.Button("Home", misplaced))
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := checkActions(screen)
+	if len(issues) != 1 || !strings.Contains(issues[0].Path, "children[1]") {
+		t.Errorf("Expected only the navigation actionId to be reported, got %v", issues)
+	}
+}
+
+func TestIDUniqueness(t *testing.T) {
+	list := ui.NewComponent("lazy_column").
+		WithID("feed").
+		WithDataSource(ui.StaticDataSource(nil)).
+		WithItemTemplate(model.ItemTemplate{
+			Type:   "post",
+			Layout: ui.NewComponent("text").WithID("title").Build(),
+		}).
+		Build()
+	layout := ui.Column(
+		ui.NewComponent("text").WithID("title").Build(),
+		list,
+		ui.NewComponent("button").WithID("feed").Build(),
+		ui.NewComponent("text").WithID("").Build(),
+	)
+	screen := ui.NewScreen("s", "S", "1.0").WithLayout(layout).Build()
+	issues := checkIDs(screen)
+
+	if len(issuesContaining(issues, `id "feed" is already used`)) != 1 {
+		t.Errorf("Expected the duplicate feed ID to be reported, got %v", issues)
+	}
+	if len(issuesContaining(issues, "empty id")) != 1 {
+		t.Errorf("Expected the empty ID to be reported, got %v", issues)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Item template IDs are scoped per item and should not clash, got %v", issues)
+	}
+}